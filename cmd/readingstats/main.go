@@ -0,0 +1,46 @@
+package main
+
+import (
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/utils"
+    "auth2_google/pkg/database"
+    "log"
+
+    "github.com/joho/godotenv"
+)
+
+// Recomputes word counts and reading times for every post and translation,
+// e.g. for posts saved before they were tracked: go run ./cmd/readingstats
+func main() {
+    if err := godotenv.Load(); err != nil {
+        log.Println("No .env file found - using system environment variables")
+    }
+
+    database.ConnectDatabase()
+
+    blogRepo := repositories.NewBlogRepository(database.DB)
+    translationRepo := repositories.NewTranslationRepository(database.DB)
+
+    posts, err := blogRepo.GetAll()
+    if err != nil {
+        log.Fatal("❌ Failed to load posts:", err)
+    }
+
+    translations := 0
+    for _, post := range posts {
+        words, minutes := utils.ReadingStats(post.Content)
+        if err := blogRepo.SetReadingStats(post.ID, words, minutes); err != nil {
+            log.Fatalf("❌ Failed to update post %d: %v", post.ID, err)
+        }
+
+        for _, translation := range post.Translations {
+            words, minutes := utils.ReadingStats(translation.Content)
+            if err := translationRepo.SetReadingStats(translation.ID, words, minutes); err != nil {
+                log.Fatalf("❌ Failed to update translation %d: %v", translation.ID, err)
+            }
+            translations++
+        }
+    }
+
+    log.Printf("✅ Reading stats updated for %d posts and %d translations", len(posts), translations)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+    "auth2_google/pkg/database"
+    "log"
+
+    "github.com/joho/godotenv"
+)
+
+// Rebuilds the search index from the database: go run ./cmd/reindex
+// A running server picks up a rebuilt embedded index on its next search or save.
+func main() {
+    if err := godotenv.Load(); err != nil {
+        log.Println("No .env file found - using system environment variables")
+    }
+
+    database.ConnectDatabase()
+
+    searchIndex, err := search.NewFromEnv(database.DB)
+    if err != nil {
+        log.Fatal("❌ Failed to set up search index:", err)
+    }
+
+    posts, err := repositories.NewBlogRepository(database.DB).GetAll()
+    if err != nil {
+        log.Fatal("❌ Failed to load posts:", err)
+    }
+
+    if err := searchIndex.Rebuild(posts); err != nil {
+        log.Fatal("❌ Failed to rebuild search index:", err)
+    }
+
+    log.Printf("✅ Search index rebuilt with %d posts", len(posts))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/imaging"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+    "auth2_google/internal/services"
+    "auth2_google/internal/storage"
+    "auth2_google/pkg/database"
+    "context"
+    "encoding/json"
+    "flag"
+    "log"
+    "os"
+
+    "github.com/joho/godotenv"
+)
+
+// Imports a WordPress WXR export. Running it again with the same export
+// only adds what's new: go run ./cmd/wpimport -file export.xml -user 1
+func main() {
+    file := flag.String("file", "", "WXR export to import")
+    userID := flag.Uint("user", 0, "ID of the account that owns the downloaded images")
+    flag.Parse()
+    if *file == "" || *userID == 0 {
+        flag.Usage()
+        os.Exit(2)
+    }
+
+    if err := godotenv.Load(); err != nil {
+        log.Println("No .env file found - using system environment variables")
+    }
+
+    database.ConnectDatabase()
+    // The server creates this table too, but the import may run first
+    if err := database.DB.AutoMigrate(&models.ImportRecord{}); err != nil {
+        log.Fatal("❌ Failed to migrate:", err)
+    }
+
+    searchIndex, err := search.NewFromEnv(database.DB)
+    if err != nil {
+        log.Fatal("❌ Failed to set up search index:", err)
+    }
+    store, err := storage.NewFromEnv()
+    if err != nil {
+        log.Fatal("❌ Failed to set up storage:", err)
+    }
+
+    userRepo := repositories.NewUserRepository(database.DB)
+    if _, err := userRepo.GetByID(uint(*userID)); err != nil {
+        log.Fatalf("❌ User %d not found", *userID)
+    }
+
+    blogRepo := repositories.NewBlogRepository(database.DB)
+    mediaRepo := repositories.NewMediaRepository(database.DB)
+    uploadService := services.NewUploadService(mediaRepo, store, imaging.NewProcessor(config.WebPEncoderPath()), config.UploadMaxBytes())
+    importService := services.NewImportService(repositories.NewImportRepository(database.DB), blogRepo, repositories.NewTagRepository(database.DB), userRepo, mediaRepo, uploadService, searchIndex, search.NewRelatedIndex(blogRepo.GetPublishedForRelated))
+
+    export, err := os.Open(*file)
+    if err != nil {
+        log.Fatal("❌ Failed to open export:", err)
+    }
+    defer export.Close()
+
+    report, err := importService.ImportWordPress(context.Background(), export, uint(*userID))
+    if err != nil {
+        log.Fatal("❌ Import failed:", err)
+    }
+
+    out, _ := json.MarshalIndent(report, "", "  ")
+    os.Stdout.Write(append(out, '\n'))
+}
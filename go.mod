@@ -5,6 +5,7 @@ go 1.24.2
 require (
 	github.com/gin-contrib/cors v1.7.5
 	github.com/gin-gonic/gin v1.10.1
+	golang.org/x/image v0.25.0
 	golang.org/x/oauth2 v0.30.0
 	gorm.io/gorm v1.25.10
 )
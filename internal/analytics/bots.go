@@ -0,0 +1,14 @@
+package analytics
+
+import "regexp"
+
+// Crawlers, link previewers, monitors and scripted clients. Real browsers
+// never match any of these.
+var botPattern = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|facebookexternalhit|embedly|preview|` +
+    `headless|lighthouse|pingdom|uptime|monitor|curl|wget|python-|httpclient|go-http-client|java/|okhttp|axios|node-fetch|postman`)
+
+// IsBot reports whether a User-Agent belongs to something other than a person reading.
+// A missing User-Agent counts as a bot.
+func IsBot(userAgent string) bool {
+    return userAgent == "" || botPattern.MatchString(userAgent)
+}
@@ -0,0 +1,42 @@
+package analytics
+
+import (
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/hex"
+    "sync"
+)
+
+// VisitorHasher turns an IP and User-Agent into an anonymous visitor ID.
+// The salt is random, kept only in memory and replaced every day, so IDs
+// can't be linked across days or traced back to an address.
+type VisitorHasher struct {
+    mu   sync.Mutex
+    day  string
+    salt []byte
+}
+
+func NewVisitorHasher() *VisitorHasher {
+    return &VisitorHasher{}
+}
+
+// Hash returns the visitor ID for day (YYYY-MM-DD in the site timezone)
+func (h *VisitorHasher) Hash(day, ip, userAgent string) string {
+    h.mu.Lock()
+    if day != h.day {
+        h.salt = make([]byte, 32)
+        if _, err := rand.Read(h.salt); err != nil {
+            panic("crypto/rand failed: " + err.Error())
+        }
+        h.day = day
+    }
+    salt := h.salt
+    h.mu.Unlock()
+
+    sum := sha256.New()
+    sum.Write(salt)
+    sum.Write([]byte(ip))
+    sum.Write([]byte{0})
+    sum.Write([]byte(userAgent))
+    return hex.EncodeToString(sum.Sum(nil)[:16])
+}
@@ -0,0 +1,54 @@
+package audio
+
+import (
+    "errors"
+    "io"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported audio format")
+
+// Info is what we can learn about an audio file from its headers, without decoding it
+type Info struct {
+    Format      string // "mp3" or "m4a"
+    ContentType string
+    Extension   string
+    Duration    float64 // Seconds
+    Bitrate     int     // Average, in kbps
+    SampleRate  int     // Hz, 0 if unknown
+    Channels    int     // 0 if unknown
+}
+
+// Probe detects MP3 or M4A from the file content and reads its duration and bitrate
+func Probe(r io.ReadSeeker, size int64) (*Info, error) {
+    head := make([]byte, 12)
+    if _, err := r.Seek(0, io.SeekStart); err != nil {
+        return nil, err
+    }
+    if _, err := io.ReadFull(r, head); err != nil {
+        return nil, ErrUnsupportedFormat
+    }
+
+    var (
+        info *Info
+        err  error
+    )
+    switch {
+    case string(head[4:8]) == "ftyp":
+        info, err = probeM4A(r, size)
+    case string(head[:3]) == "ID3" || (head[0] == 0xFF && head[1]&0xE0 == 0xE0):
+        info, err = probeMP3(r, size)
+    default:
+        return nil, ErrUnsupportedFormat
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    if info.Duration <= 0 {
+        return nil, ErrUnsupportedFormat
+    }
+    if info.Bitrate == 0 {
+        info.Bitrate = int(float64(size) * 8 / info.Duration / 1000)
+    }
+    return info, nil
+}
@@ -0,0 +1,127 @@
+package audio
+
+import (
+    "encoding/binary"
+    "io"
+)
+
+type mp4Box struct {
+    kind  string
+    start int64 // Offset of the box payload
+    end   int64
+}
+
+// Read the boxes between start and end
+func readBoxes(r io.ReadSeeker, start, end int64) ([]mp4Box, error) {
+    var boxes []mp4Box
+    header := make([]byte, 16)
+
+    for pos := start; pos+8 <= end; {
+        if _, err := r.Seek(pos, io.SeekStart); err != nil {
+            return nil, err
+        }
+        if _, err := io.ReadFull(r, header[:8]); err != nil {
+            return nil, err
+        }
+
+        size := int64(binary.BigEndian.Uint32(header))
+        kind := string(header[4:8])
+        headerLen := int64(8)
+        switch size {
+        case 0: // Box runs to the end of its parent
+            size = end - pos
+        case 1: // 64-bit size follows
+            if _, err := io.ReadFull(r, header[8:16]); err != nil {
+                return nil, err
+            }
+            size = int64(binary.BigEndian.Uint64(header[8:]))
+            headerLen = 16
+        }
+        if size < headerLen || pos+size > end {
+            return nil, ErrUnsupportedFormat
+        }
+
+        boxes = append(boxes, mp4Box{kind: kind, start: pos + headerLen, end: pos + size})
+        pos += size
+    }
+    return boxes, nil
+}
+
+// Walk down a box path such as moov/trak/mdia, taking the first match at each level
+func findBox(r io.ReadSeeker, start, end int64, path ...string) (*mp4Box, error) {
+    for i, kind := range path {
+        boxes, err := readBoxes(r, start, end)
+        if err != nil {
+            return nil, err
+        }
+
+        var found *mp4Box
+        for j := range boxes {
+            if boxes[j].kind == kind {
+                found = &boxes[j]
+                break
+            }
+        }
+        if found == nil {
+            return nil, ErrUnsupportedFormat
+        }
+        if i == len(path)-1 {
+            return found, nil
+        }
+        start, end = found.start, found.end
+    }
+    return nil, ErrUnsupportedFormat
+}
+
+func readAt(r io.ReadSeeker, offset int64, n int) ([]byte, error) {
+    buf := make([]byte, n)
+    if _, err := r.Seek(offset, io.SeekStart); err != nil {
+        return nil, err
+    }
+    if _, err := io.ReadFull(r, buf); err != nil {
+        return nil, ErrUnsupportedFormat
+    }
+    return buf, nil
+}
+
+func probeM4A(r io.ReadSeeker, size int64) (*Info, error) {
+    // Overall duration from the movie header
+    mvhd, err := findBox(r, 0, size, "moov", "mvhd")
+    if err != nil {
+        return nil, err
+    }
+    data, err := readAt(r, mvhd.start, 32)
+    if err != nil {
+        return nil, err
+    }
+
+    var timescale, duration uint64
+    if data[0] == 1 { // Version 1 uses 64-bit times
+        timescale = uint64(binary.BigEndian.Uint32(data[20:]))
+        duration = binary.BigEndian.Uint64(data[24:])
+    } else {
+        timescale = uint64(binary.BigEndian.Uint32(data[12:]))
+        duration = uint64(binary.BigEndian.Uint32(data[16:]))
+    }
+    if timescale == 0 {
+        return nil, ErrUnsupportedFormat
+    }
+
+    info := &Info{
+        Format:      "m4a",
+        ContentType: "audio/mp4",
+        Extension:   ".m4a",
+        Duration:    float64(duration) / float64(timescale),
+    }
+
+    // Channels and sample rate from the first sample description, when it is AAC
+    if stsd, err := findBox(r, 0, size, "moov", "trak", "mdia", "minf", "stbl", "stsd"); err == nil {
+        // stsd: version/flags(4) + entry count(4), then the sample entry box
+        if entry, err := readAt(r, stsd.start+8, 36); err == nil && string(entry[4:8]) == "mp4a" {
+            info.Channels = int(binary.BigEndian.Uint16(entry[24:]))
+            info.SampleRate = int(binary.BigEndian.Uint32(entry[32:]) >> 16)
+        }
+    }
+
+    return info, nil
+}
@@ -0,0 +1,173 @@
+package audio
+
+import (
+    "encoding/binary"
+    "io"
+)
+
+var (
+    // kbps by bitrate index, for Layer III
+    mpeg1Bitrates = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+    mpeg2Bitrates = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+
+    sampleRates = map[int][3]int{
+        3: {44100, 48000, 32000}, // MPEG 1
+        2: {22050, 24000, 16000}, // MPEG 2
+        0: {11025, 12000, 8000},  // MPEG 2.5
+    }
+)
+
+type mp3Frame struct {
+    version    int // 3 = MPEG1, 2 = MPEG2, 0 = MPEG2.5
+    bitrate    int // kbps
+    sampleRate int
+    mono       bool
+    length     int // Bytes, header included
+}
+
+func (f mp3Frame) samples() int {
+    if f.version == 3 {
+        return 1152
+    }
+    return 576
+}
+
+func parseMP3Frame(header []byte) (mp3Frame, bool) {
+    if len(header) < 4 || header[0] != 0xFF || header[1]&0xE0 != 0xE0 {
+        return mp3Frame{}, false
+    }
+
+    version := int(header[1]>>3) & 3
+    layer := int(header[1]>>1) & 3
+    bitrateIndex := int(header[2] >> 4)
+    sampleRateIndex := int(header[2]>>2) & 3
+    padding := int(header[2]>>1) & 1
+
+    if version == 1 || layer != 1 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+        return mp3Frame{}, false // Reserved values, free format, or not Layer III
+    }
+
+    frame := mp3Frame{
+        version:    version,
+        sampleRate: sampleRates[version][sampleRateIndex],
+        mono:       header[3]>>6 == 3,
+    }
+    if version == 3 {
+        frame.bitrate = mpeg1Bitrates[bitrateIndex]
+        frame.length = 144*frame.bitrate*1000/frame.sampleRate + padding
+    } else {
+        frame.bitrate = mpeg2Bitrates[bitrateIndex]
+        frame.length = 72*frame.bitrate*1000/frame.sampleRate + padding
+    }
+    return frame, true
+}
+
+func probeMP3(r io.ReadSeeker, size int64) (*Info, error) {
+    // Skip the ID3v2 tag
+    offset := int64(0)
+    header := make([]byte, 10)
+    if _, err := r.Seek(0, io.SeekStart); err != nil {
+        return nil, err
+    }
+    if _, err := io.ReadFull(r, header); err != nil {
+        return nil, ErrUnsupportedFormat
+    }
+    if string(header[:3]) == "ID3" {
+        tagSize := int64(header[6]&0x7F)<<21 | int64(header[7]&0x7F)<<14 | int64(header[8]&0x7F)<<7 | int64(header[9]&0x7F)
+        offset = 10 + tagSize
+        if header[5]&0x10 != 0 {
+            offset += 10 // Footer
+        }
+    }
+
+    // Find the first frame whose successor is also a valid frame, to avoid false syncs
+    buf := make([]byte, 64*1024)
+    if _, err := r.Seek(offset, io.SeekStart); err != nil {
+        return nil, err
+    }
+    n, _ := io.ReadFull(r, buf)
+    buf = buf[:n]
+
+    for i := 0; i+4 <= len(buf); i++ {
+        frame, ok := parseMP3Frame(buf[i:])
+        if !ok {
+            continue
+        }
+        if next := i + frame.length; next+4 <= len(buf) {
+            if _, ok := parseMP3Frame(buf[next:]); !ok {
+                continue
+            }
+        }
+        return mp3Info(frame, buf[i:], size-offset-int64(i)-id3v1Size(r, size)), nil
+    }
+    return nil, ErrUnsupportedFormat
+}
+
+// ID3v1 tags sit in the last 128 bytes of the file
+func id3v1Size(r io.ReadSeeker, size int64) int64 {
+    if size < 128 {
+        return 0
+    }
+    tag := make([]byte, 3)
+    if _, err := r.Seek(size-128, io.SeekStart); err != nil {
+        return 0
+    }
+    if _, err := io.ReadFull(r, tag); err != nil || string(tag) != "TAG" {
+        return 0
+    }
+    return 128
+}
+
+func mp3Info(frame mp3Frame, data []byte, audioBytes int64) *Info {
+    info := &Info{
+        Format:      "mp3",
+        ContentType: "audio/mpeg",
+        Extension:   ".mp3",
+        SampleRate:  frame.sampleRate,
+        Channels:    2,
+    }
+    if frame.mono {
+        info.Channels = 1
+    }
+
+    // VBR files carry the total frame count in a Xing/Info or VBRI header inside the first frame
+    if frames := vbrFrameCount(frame, data); frames > 0 {
+        info.Duration = float64(frames) * float64(frame.samples()) / float64(frame.sampleRate)
+        info.Bitrate = int(float64(audioBytes) * 8 / info.Duration / 1000)
+        return info
+    }
+
+    info.Bitrate = frame.bitrate
+    info.Duration = float64(audioBytes) * 8 / float64(frame.bitrate*1000)
+    return info
+}
+
+func vbrFrameCount(frame mp3Frame, data []byte) int {
+    // Xing/Info follows the side information, whose size depends on version and channels
+    sideInfo := 32
+    switch {
+    case frame.version == 3 && frame.mono:
+        sideInfo = 17
+    case frame.version != 3 && !frame.mono:
+        sideInfo = 17
+    case frame.version != 3 && frame.mono:
+        sideInfo = 9
+    }
+
+    xing := 4 + sideInfo
+    if len(data) >= xing+12 {
+        tag := string(data[xing : xing+4])
+        if tag == "Xing" || tag == "Info" {
+            flags := binary.BigEndian.Uint32(data[xing+4:])
+            if flags&1 != 0 {
+                return int(binary.BigEndian.Uint32(data[xing+8:]))
+            }
+        }
+    }
+
+    const vbri = 4 + 32
+    if len(data) >= vbri+18 && string(data[vbri:vbri+4]) == "VBRI" {
+        return int(binary.BigEndian.Uint32(data[vbri+14:]))
+    }
+    return 0
+}
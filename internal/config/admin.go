@@ -0,0 +1,17 @@
+package config
+
+import (
+    "os"
+    "strings"
+)
+
+// IsAdminEmail reports whether the email is listed in ADMIN_EMAILS (comma-separated).
+// Listed users get the admin role when they log in.
+func IsAdminEmail(email string) bool {
+    for _, admin := range strings.Split(os.Getenv("ADMIN_EMAILS"), ",") {
+        if admin = strings.TrimSpace(admin); admin != "" && strings.EqualFold(admin, email) {
+            return true
+        }
+    }
+    return false
+}
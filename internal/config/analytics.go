@@ -0,0 +1,15 @@
+package config
+
+import (
+    "os"
+    "strconv"
+    "time"
+)
+
+// AnalyticsFlushInterval is how often buffered page views are written out (ANALYTICS_FLUSH_SECONDS)
+func AnalyticsFlushInterval() time.Duration {
+    if seconds, err := strconv.Atoi(os.Getenv("ANALYTICS_FLUSH_SECONDS")); err == nil && seconds > 0 {
+        return time.Duration(seconds) * time.Second
+    }
+    return 30 * time.Second
+}
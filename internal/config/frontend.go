@@ -0,0 +1,42 @@
+package config
+
+import (
+    "fmt"
+    "net/url"
+    "os"
+    "strings"
+)
+
+// FrontendURL is the public site that links in API responses point to
+func FrontendURL() string {
+    frontendURL := os.Getenv("FRONTEND_URL")
+    if frontendURL == "" {
+        frontendURL = "https://finbanglavoice.fi"
+    }
+    return strings.TrimRight(frontendURL, "/")
+}
+
+// PublicBaseURL is this API's own public address, for links that point back at the backend.
+// PUBLIC_BASE_URL is required, so staging and local copies never link to production.
+func PublicBaseURL() string {
+    return strings.TrimRight(os.Getenv("PUBLIC_BASE_URL"), "/")
+}
+
+// PostURL is where readers find a post on the frontend
+func PostURL(postID uint) string {
+    return fmt.Sprintf("%s/blog/%d", FrontendURL(), postID)
+}
+
+// SeriesURL is the landing page of a multi-part series
+func SeriesURL(slug string) string {
+    return fmt.Sprintf("%s/series/%s", FrontendURL(), url.PathEscape(slug))
+}
+
+// LocalizedPostURL points at one language version of a post. The canonical
+// language lives at the plain post URL.
+func LocalizedPostURL(postID uint, lang, canonicalLang string) string {
+    if lang == canonicalLang {
+        return PostURL(postID)
+    }
+    return fmt.Sprintf("%s?lang=%s", PostURL(postID), lang)
+}
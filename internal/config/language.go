@@ -0,0 +1,39 @@
+package config
+
+import (
+    "os"
+    "strings"
+)
+
+// DefaultLanguage is the language new posts are written in unless they say otherwise (SITE_LANGUAGE)
+func DefaultLanguage() string {
+    if lang := os.Getenv("SITE_LANGUAGE"); lang != "" {
+        return strings.ToLower(lang)
+    }
+    return "bn"
+}
+
+// SupportedLanguages lists the languages posts can be written or translated into (SITE_LANGUAGES, comma-separated)
+func SupportedLanguages() []string {
+    value := os.Getenv("SITE_LANGUAGES")
+    if value == "" {
+        value = "bn,en"
+    }
+
+    var langs []string
+    for _, lang := range strings.Split(value, ",") {
+        if lang = strings.ToLower(strings.TrimSpace(lang)); lang != "" {
+            langs = append(langs, lang)
+        }
+    }
+    return langs
+}
+
+func IsSupportedLanguage(lang string) bool {
+    for _, supported := range SupportedLanguages() {
+        if lang == supported {
+            return true
+        }
+    }
+    return false
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+    "fmt"
+    "os"
+    "strconv"
+    "time"
+)
+
+// PreviewSecret signs draft preview links
+func PreviewSecret() string {
+    if secret := os.Getenv("PREVIEW_SECRET"); secret != "" {
+        return secret
+    }
+    return os.Getenv("JWT_SECRET")
+}
+
+// PreviewTTL is how long a preview link works when the author doesn't say (PREVIEW_TTL_HOURS)
+func PreviewTTL() time.Duration {
+    if hours, err := strconv.Atoi(os.Getenv("PREVIEW_TTL_HOURS")); err == nil && hours > 0 {
+        return time.Duration(hours) * time.Hour
+    }
+    return 7 * 24 * time.Hour
+}
+
+// PreviewURL is the frontend page that shows a draft to whoever has the link
+func PreviewURL(token string) string {
+    return fmt.Sprintf("%s/preview/%s", FrontendURL(), token)
+}
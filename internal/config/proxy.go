@@ -0,0 +1,19 @@
+package config
+
+import (
+    "os"
+    "strings"
+)
+
+// TrustedProxies lists the reverse proxies whose X-Forwarded-For is believed
+// (TRUSTED_PROXIES, comma-separated IPs or CIDRs). Unset trusts none, so clients
+// can't pick their own IP to get around rate limits.
+func TrustedProxies() []string {
+    var proxies []string
+    for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+        if proxy = strings.TrimSpace(proxy); proxy != "" {
+            proxies = append(proxies, proxy)
+        }
+    }
+    return proxies
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+    "os"
+    "strings"
+)
+
+// Reactions lists the reactions readers can leave on posts, in display order (REACTIONS, comma-separated)
+func Reactions() []string {
+    value := os.Getenv("REACTIONS")
+    if value == "" {
+        value = "like,love,insightful"
+    }
+
+    var reactions []string
+    for _, reaction := range strings.Split(value, ",") {
+        if reaction = strings.ToLower(strings.TrimSpace(reaction)); reaction != "" {
+            reactions = append(reactions, reaction)
+        }
+    }
+    return reactions
+}
+
+func IsReaction(reaction string) bool {
+    for _, allowed := range Reactions() {
+        if reaction == allowed {
+            return true
+        }
+    }
+    return false
+}
+
+// FingerprintSecret keys the hash of anonymous readers' fingerprints, so the raw values are never stored
+func FingerprintSecret() string {
+    if secret := os.Getenv("FINGERPRINT_SECRET"); secret != "" {
+        return secret
+    }
+    return os.Getenv("JWT_SECRET")
+}
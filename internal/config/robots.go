@@ -0,0 +1,27 @@
+package config
+
+import (
+    "os"
+    "strings"
+)
+
+// RobotsDisallow lists the paths crawlers are asked to skip (ROBOTS_DISALLOW, comma-separated)
+func RobotsDisallow() []string {
+    value, ok := os.LookupEnv("ROBOTS_DISALLOW")
+    if !ok {
+        return []string{"/api/admin/", "/auth/"}
+    }
+
+    var paths []string
+    for _, path := range strings.Split(value, ",") {
+        if path = strings.TrimSpace(path); path != "" {
+            paths = append(paths, path)
+        }
+    }
+    return paths
+}
+
+// RobotsBlockAll keeps staging and preview deployments out of search engines (ROBOTS_BLOCK_ALL=true)
+func RobotsBlockAll() bool {
+    return os.Getenv("ROBOTS_BLOCK_ALL") == "true"
+}
@@ -0,0 +1,34 @@
+package config
+
+import "os"
+
+// SiteTitle names the publication in feeds and share metadata
+func SiteTitle() string {
+    if title := os.Getenv("SITE_TITLE"); title != "" {
+        return title
+    }
+    return "FinBangla Voice"
+}
+
+func SiteDescription() string {
+    if description := os.Getenv("SITE_DESCRIPTION"); description != "" {
+        return description
+    }
+    return "News and stories for the Bangladeshi community in Finland"
+}
+
+// FeedFullContent reports whether feeds include whole articles by default (FEED_CONTENT_MODE=full)
+// rather than just excerpts. Readers can override it per request with ?mode=.
+func FeedFullContent() bool {
+    return os.Getenv("FEED_CONTENT_MODE") == "full"
+}
+
+// DefaultShareImage is used in link previews for posts without an image (SHARE_IMAGE_URL)
+func DefaultShareImage() string {
+    return os.Getenv("SHARE_IMAGE_URL")
+}
+
+// TwitterSite is the publication's @handle for Twitter Cards (TWITTER_SITE)
+func TwitterSite() string {
+    return os.Getenv("TWITTER_SITE")
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+    "fmt"
+    "os"
+    "strconv"
+)
+
+// SubmissionMaxBytes reads SUBMISSION_MAX_MB, defaulting to 10 MB per attachment
+func SubmissionMaxBytes() int64 {
+    megabytes, err := strconv.ParseInt(os.Getenv("SUBMISSION_MAX_MB"), 10, 64)
+    if err != nil || megabytes <= 0 {
+        megabytes = 10
+    }
+    return megabytes << 20
+}
+
+// SubmissionDailyLimit is how many pitches one visitor can send per day (SUBMISSIONS_PER_DAY)
+func SubmissionDailyLimit() int {
+    if limit, err := strconv.Atoi(os.Getenv("SUBMISSIONS_PER_DAY")); err == nil && limit > 0 {
+        return limit
+    }
+    return 3
+}
+
+// SubmissionRateSecret keys the hash of guests' IPs for rate limiting, so it
+// must stay the same across restarts (SUBMISSION_SECRET, falling back to JWT_SECRET)
+func SubmissionRateSecret() string {
+    if secret := os.Getenv("SUBMISSION_SECRET"); secret != "" {
+        return secret
+    }
+    return os.Getenv("JWT_SECRET")
+}
+
+// SubmissionStatusURL is the secret frontend page where a guest follows their pitch
+func SubmissionStatusURL(token string) string {
+    return fmt.Sprintf("%s/submissions/%s", FrontendURL(), token)
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+    "log"
+    "os"
+    "strings"
+    "sync"
+    "time"
+    _ "time/tzdata" // Containers often ship without zoneinfo
+)
+
+var (
+    timezoneOnce sync.Once
+    timezone     *time.Location
+)
+
+// Timezone is where dates in responses are rendered (SITE_TIMEZONE, IANA name)
+func Timezone() *time.Location {
+    timezoneOnce.Do(func() {
+        name := os.Getenv("SITE_TIMEZONE")
+        if name == "" {
+            name = "Europe/Helsinki"
+        }
+
+        location, err := time.LoadLocation(name)
+        if err != nil {
+            log.Printf("⚠️ Unknown SITE_TIMEZONE %q, using UTC", name)
+            location = time.UTC
+        }
+        timezone = location
+    })
+    return timezone
+}
+
+// DateLocale formats dates for readers who don't ask for a language (DATE_LOCALE: en, bn or fi)
+func DateLocale() string {
+    if locale := os.Getenv("DATE_LOCALE"); locale != "" {
+        return strings.ToLower(locale)
+    }
+    return "en"
+}
@@ -0,0 +1,16 @@
+package config
+
+import (
+    "os"
+    "strconv"
+    "time"
+)
+
+// TrashRetention is how long deleted posts and comments stay restorable (TRASH_RETENTION_DAYS).
+// 0 keeps them until purged by hand.
+func TrashRetention() time.Duration {
+    if days, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS")); err == nil && days >= 0 {
+        return time.Duration(days) * 24 * time.Hour
+    }
+    return 30 * 24 * time.Hour
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+    "os"
+    "os/exec"
+    "strconv"
+)
+
+// UploadMaxBytes reads UPLOAD_MAX_MB, defaulting to 10 MB per image
+func UploadMaxBytes() int64 {
+    megabytes, err := strconv.ParseInt(os.Getenv("UPLOAD_MAX_MB"), 10, 64)
+    if err != nil || megabytes <= 0 {
+        megabytes = 10
+    }
+    return megabytes << 20
+}
+
+// WebPEncoderPath finds cwebp (or IMAGE_WEBP_ENCODER). Empty means WebP variants are skipped.
+func WebPEncoderPath() string {
+    if path := os.Getenv("IMAGE_WEBP_ENCODER"); path != "" {
+        return path
+    }
+    path, err := exec.LookPath("cwebp")
+    if err != nil {
+        return ""
+    }
+    return path
+}
+
+// AudioMaxBytes reads AUDIO_MAX_MB, defaulting to 200 MB per narration
+func AudioMaxBytes() int64 {
+    megabytes, err := strconv.ParseInt(os.Getenv("AUDIO_MAX_MB"), 10, 64)
+    if err != nil || megabytes <= 0 {
+        megabytes = 200
+    }
+    return megabytes << 20
+}
+
+// ImportMaxBytes reads IMPORT_MAX_MB, defaulting to 100 MB per export file
+func ImportMaxBytes() int64 {
+    megabytes, err := strconv.ParseInt(os.Getenv("IMPORT_MAX_MB"), 10, 64)
+    if err != nil || megabytes <= 0 {
+        megabytes = 100
+    }
+    return megabytes << 20
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "io"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type AnalyticsController struct {
+    analyticsService services.AnalyticsServiceInterface
+}
+
+func NewAnalyticsController(analyticsService services.AnalyticsServiceInterface) *AnalyticsController {
+    return &AnalyticsController{
+        analyticsService: analyticsService,
+    }
+}
+
+// POST /api/posts/:id/views - Count a page view. No cookies, the body is optional.
+func (ctrl *AnalyticsController) RecordView(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    var req models.RecordViewRequest
+    if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+    if req.Referrer == "" {
+        req.Referrer = c.GetHeader("Referer")
+    }
+
+    c.Header("Cache-Control", "no-store")
+    err = ctrl.analyticsService.RecordView(uint(postID), c.ClientIP(), c.GetHeader("User-Agent"), req.Referrer)
+    if err != nil {
+        if errors.Is(err, services.ErrPostNotFound) {
+            c.JSON(http.StatusNotFound, gin.H{
+                "success": false,
+                "error":   "Post not found",
+            })
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to record view",
+        })
+        return
+    }
+
+    c.JSON(http.StatusAccepted, gin.H{
+        "success": true,
+    })
+}
+
+// GET /api/admin/analytics?days=30 - Top posts, referrers and daily trend
+func (ctrl *AnalyticsController) GetReport(c *gin.Context) {
+    days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid number of days",
+        })
+        return
+    }
+
+    report, err := ctrl.analyticsService.GetReport(days)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":   true,
+        "analytics": report,
+    })
+}
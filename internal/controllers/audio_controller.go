@@ -0,0 +1,165 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/services"
+    "errors"
+    "fmt"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type AudioController struct {
+    audioService services.AudioServiceInterface
+}
+
+func NewAudioController(audioService services.AudioServiceInterface) *AudioController {
+    return &AudioController{
+        audioService: audioService,
+    }
+}
+
+// POST /api/posts/:id/audio - Attach or replace the narrated audio (multipart form field "file")
+func (ctrl *AudioController) UploadAudio(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    userID, ok := middleware.CurrentUserID(c)
+    if !ok {
+        c.JSON(http.StatusUnauthorized, gin.H{
+            "success": false,
+            "error":   "Authentication required",
+        })
+        return
+    }
+
+    maxBytes := ctrl.audioService.MaxAudioBytes()
+    c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+1<<20)
+
+    file, header, err := c.Request.FormFile("file")
+    if err != nil {
+        var maxBytesErr *http.MaxBytesError
+        if errors.As(err, &maxBytesErr) {
+            ctrl.fileTooLarge(c, maxBytes)
+            return
+        }
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "A file is required in the \"file\" field",
+        })
+        return
+    }
+    defer file.Close()
+
+    audio, err := ctrl.audioService.AttachAudio(c.Request.Context(), uint(postID), userID, header.Filename, file, header.Size)
+    if err != nil {
+        switch {
+        case errors.Is(err, services.ErrFileTooLarge):
+            ctrl.fileTooLarge(c, maxBytes)
+        case errors.Is(err, services.ErrUnsupportedMediaType):
+            c.JSON(http.StatusUnsupportedMediaType, gin.H{
+                "success": false,
+                "error":   "Only MP3 and M4A audio files are allowed",
+            })
+        case errors.Is(err, services.ErrPostNotFound):
+            c.JSON(http.StatusNotFound, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+        case errors.Is(err, services.ErrPostForbidden):
+            c.JSON(http.StatusForbidden, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+        default:
+            c.JSON(http.StatusInternalServerError, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+        }
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success": true,
+        "message": "Audio attached successfully",
+        "audio":   audio,
+    })
+}
+
+// GET /api/posts/:id/audio - Stream the audio, supports Range requests for seeking
+func (ctrl *AudioController) StreamAudio(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    file, audio, err := ctrl.audioService.OpenAudio(c.Request.Context(), uint(postID))
+    if err != nil {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    defer file.Close()
+
+    // ServeContent handles Range, If-Range and HEAD for us
+    c.Header("Content-Type", audio.ContentType)
+    c.Header("Accept-Ranges", "bytes")
+    c.Header("Cache-Control", "public, max-age=3600")
+    http.ServeContent(c.Writer, c.Request, "", audio.UpdatedAt, file)
+}
+
+// DELETE /api/posts/:id/audio - Remove the narrated audio
+func (ctrl *AudioController) DeleteAudio(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    err = ctrl.audioService.DeleteAudio(c.Request.Context(), uint(postID), userID)
+    if errors.Is(err, services.ErrPostForbidden) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Audio removed successfully",
+    })
+}
+
+func (ctrl *AudioController) fileTooLarge(c *gin.Context, maxBytes int64) {
+    c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+        "success": false,
+        "error":   fmt.Sprintf("File must be at most %d MB", maxBytes>>20),
+    })
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
 
 	"auth2_google/internal/config"
 	"auth2_google/internal/models"
@@ -79,12 +78,7 @@ func GoogleCallback(c *gin.Context) {
         })
         return
     }
-    frontendURL := os.Getenv("FRONTEND_URL")
-    if frontendURL == "" {
-        frontendURL = "https://finbanglavoice.fi"
-    }
-    
-    redirectURL := fmt.Sprintf("%s/auth/success?token=%s", frontendURL, jwtToken)
+    redirectURL := fmt.Sprintf("%s/auth/success?token=%s", config.FrontendURL(), jwtToken)
     c.Redirect(http.StatusTemporaryRedirect, redirectURL)
 
     // // 🆕 NEW: Store JWT in secure cookie
@@ -142,6 +136,10 @@ func saveOrUpdateUser(googleUser *models.GoogleUserInfo) (*models.User, error) {
             Email:    googleUser.Email,
             Name:     googleUser.Name,
             Picture:  googleUser.Picture,
+            Role:     models.RoleReader,
+        }
+        if config.IsAdminEmail(googleUser.Email) {
+            user.Role = models.RoleAdmin
         }
         
         if err := database.DB.Create(&user).Error; err != nil {
@@ -151,14 +149,19 @@ func saveOrUpdateUser(googleUser *models.GoogleUserInfo) (*models.User, error) {
         fmt.Printf("✅ New user created: %s (%s)\n", user.Name, user.Email)
     } else {
     // User exists, check if any data has changed before updating
+    promote := config.IsAdminEmail(googleUser.Email) && user.Role != models.RoleAdmin
     needsUpdate := user.Email != googleUser.Email || 
                    user.Name != googleUser.Name || 
-                   user.Picture != googleUser.Picture
+                   user.Picture != googleUser.Picture ||
+                   promote
     
     if needsUpdate {
         user.Email = googleUser.Email
         user.Name = googleUser.Name
         user.Picture = googleUser.Picture
+        if promote {
+            user.Role = models.RoleAdmin
+        }
         
         if err := database.DB.Save(&user).Error; err != nil {
             return nil, fmt.Errorf("failed to update user: %v", err)
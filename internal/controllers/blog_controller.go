@@ -1,8 +1,10 @@
 package controllers
 
 import (
+    "auth2_google/internal/middleware"
     "auth2_google/internal/models"
     "auth2_google/internal/services"
+    "errors"
     "net/http"
     "strconv"
 
@@ -23,7 +25,7 @@ func NewBlogController(blogService services.BlogServiceInterface) *BlogControlle
 }
 
 func (ctrl *BlogController) GetAllPosts(c *gin.Context) {
-    posts, err := ctrl.blogService.GetAllPosts()
+    posts, err := ctrl.blogService.GetAllPosts(readOptions(c))
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -39,7 +41,7 @@ func (ctrl *BlogController) GetAllPosts(c *gin.Context) {
 }
 
 func (ctrl *BlogController) GetPublishedPosts(c *gin.Context) {
-    posts, err := ctrl.blogService.GetPublishedPosts()
+    posts, err := ctrl.blogService.GetPublishedPosts(readOptions(c))
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -65,7 +67,7 @@ func (ctrl *BlogController) GetPost(c *gin.Context) {
         return
     }
 
-    post, err := ctrl.blogService.GetPostByID(uint(id))
+    post, err := ctrl.blogService.GetPostByID(uint(id), readOptions(c))
     if err != nil {
         c.JSON(http.StatusNotFound, gin.H{
             "success": false,
@@ -74,6 +76,7 @@ func (ctrl *BlogController) GetPost(c *gin.Context) {
         return
     }
 
+    c.Header("Content-Language", post.Language)
     c.JSON(http.StatusOK, gin.H{
         "success": true,
         "post":    post,
@@ -104,7 +107,24 @@ func (ctrl *BlogController) CreatePost(c *gin.Context) {
     }
     
     log.Printf("✅ Parsed Successfully: %+v", req)
-    post, err := ctrl.blogService.CreatePost(req)
+
+    userID, ok := middleware.CurrentUserID(c)
+    if !ok {
+        c.JSON(http.StatusUnauthorized, gin.H{
+            "success": false,
+            "error":   "Authentication required",
+        })
+        return
+    }
+
+    post, err := ctrl.blogService.CreatePost(req, userID)
+    if errors.Is(err, services.ErrNeedsApproval) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -140,7 +160,23 @@ func (ctrl *BlogController) UpdatePost(c *gin.Context) {
         return
     }
 
-    post, err := ctrl.blogService.UpdatePost(uint(id), req)
+    userID, ok := middleware.CurrentUserID(c)
+    if !ok {
+        c.JSON(http.StatusUnauthorized, gin.H{
+            "success": false,
+            "error":   "Authentication required",
+        })
+        return
+    }
+
+    post, err := ctrl.blogService.UpdatePost(uint(id), userID, req)
+    if errors.Is(err, services.ErrNeedsApproval) || errors.Is(err, services.ErrPostForbidden) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
     if err != nil {
         c.JSON(http.StatusNotFound, gin.H{
             "success": false,
@@ -167,17 +203,113 @@ func (ctrl *BlogController) DeletePost(c *gin.Context) {
         return
     }
 
-    err = ctrl.blogService.DeletePost(uint(id))
-    if err != nil {
+    userID, ok := middleware.CurrentUserID(c)
+    if !ok {
+        c.JSON(http.StatusUnauthorized, gin.H{
+            "success": false,
+            "error":   "Authentication required",
+        })
+        return
+    }
+
+    // ?comments=archive keeps the comments out of the trash
+    err = ctrl.blogService.DeletePost(uint(id), userID, c.DefaultQuery("comments", models.CommentsDelete))
+    if errors.Is(err, services.ErrPostNotFound) {
         c.JSON(http.StatusNotFound, gin.H{
             "success": false,
             "error":   err.Error(),
         })
         return
     }
+    if errors.Is(err, services.ErrPostForbidden) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
 
     c.JSON(http.StatusOK, gin.H{
         "success": true,
         "message": "Post deleted successfully",
     })
-}
\ No newline at end of file
+}
+
+// GET /api/posts/search?q=...&limit=... - Full-text search over posts
+func (ctrl *BlogController) SearchPosts(c *gin.Context) {
+    query := strings.TrimSpace(c.Query("q"))
+    if query == "" {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Search query is required",
+        })
+        return
+    }
+
+    limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+    if err != nil || limit < 1 || limit > 100 {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Limit must be between 1 and 100",
+        })
+        return
+    }
+
+    posts, err := ctrl.blogService.SearchPosts(query, limit, readOptions(c))
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to search posts",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "query":   query,
+        "posts":   posts,
+    })
+}
+
+// POST /api/posts/bulk - Publish, unpublish, tag, retag, delete or re-author many posts at once
+func (ctrl *BlogController) BulkUpdatePosts(c *gin.Context) {
+    var req models.BulkPostRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    report, err := ctrl.blogService.BulkUpdate(req)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    if !report.Applied {
+        c.JSON(http.StatusUnprocessableEntity, gin.H{
+            "success": false,
+            "error":   "No posts were changed, see results for the posts that failed",
+            "report":  report,
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Posts updated successfully",
+        "report":  report,
+    })
+}
@@ -0,0 +1,328 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type BookmarkController struct {
+    bookmarkService services.BookmarkServiceInterface
+}
+
+func NewBookmarkController(bookmarkService services.BookmarkServiceInterface) *BookmarkController {
+    return &BookmarkController{
+        bookmarkService: bookmarkService,
+    }
+}
+
+// Map service errors onto responses for every handler here
+func bookmarkError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, services.ErrPostNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+    case errors.Is(err, services.ErrReadingListNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Reading list not found",
+        })
+    default:
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+    }
+}
+
+// GET /api/me/bookmarks - Saved posts, most recently saved first
+func (ctrl *BookmarkController) GetBookmarks(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+
+    posts, err := ctrl.bookmarkService.GetBookmarks(userID, readOptions(c))
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get bookmarks",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "posts":   posts,
+    })
+}
+
+// PUT /api/me/bookmarks/:postId - Save a post, saving twice is fine
+func (ctrl *BookmarkController) SaveBookmark(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    if err := ctrl.bookmarkService.SaveBookmark(userID, uint(postID)); err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Post saved",
+    })
+}
+
+// DELETE /api/me/bookmarks/:postId - Unsave a post and remove it from reading lists
+func (ctrl *BookmarkController) RemoveBookmark(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    if err := ctrl.bookmarkService.RemoveBookmark(userID, uint(postID)); err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Post removed from saved posts",
+    })
+}
+
+// GET /api/me/lists
+func (ctrl *BookmarkController) GetReadingLists(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+
+    lists, err := ctrl.bookmarkService.GetReadingLists(userID)
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get reading lists",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "lists":   lists,
+    })
+}
+
+// GET /api/me/lists/:listId - A list with its posts in order
+func (ctrl *BookmarkController) GetReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    listID, err := strconv.ParseUint(c.Param("listId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid reading list ID",
+        })
+        return
+    }
+
+    list, err := ctrl.bookmarkService.GetReadingList(userID, uint(listID), readOptions(c))
+    if err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "list":    list,
+    })
+}
+
+// POST /api/me/lists
+func (ctrl *BookmarkController) CreateReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+
+    var req models.SaveReadingListRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    list, err := ctrl.bookmarkService.CreateReadingList(userID, req)
+    if err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success": true,
+        "message": "Reading list created successfully",
+        "list":    list,
+    })
+}
+
+// PUT /api/me/lists/:listId - Rename a list
+func (ctrl *BookmarkController) RenameReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    listID, err := strconv.ParseUint(c.Param("listId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid reading list ID",
+        })
+        return
+    }
+
+    var req models.SaveReadingListRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    list, err := ctrl.bookmarkService.RenameReadingList(userID, uint(listID), req)
+    if err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Reading list updated successfully",
+        "list":    list,
+    })
+}
+
+// DELETE /api/me/lists/:listId - The posts stay bookmarked
+func (ctrl *BookmarkController) DeleteReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    listID, err := strconv.ParseUint(c.Param("listId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid reading list ID",
+        })
+        return
+    }
+
+    if err := ctrl.bookmarkService.DeleteReadingList(userID, uint(listID)); err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Reading list deleted successfully",
+    })
+}
+
+// PUT /api/me/lists/:listId/posts/:postId - Add a post to the end of a list
+func (ctrl *BookmarkController) AddToReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    listID, err := strconv.ParseUint(c.Param("listId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid reading list ID",
+        })
+        return
+    }
+    postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    if err := ctrl.bookmarkService.AddToReadingList(userID, uint(listID), uint(postID)); err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Post added to reading list",
+    })
+}
+
+// DELETE /api/me/lists/:listId/posts/:postId
+func (ctrl *BookmarkController) RemoveFromReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    listID, err := strconv.ParseUint(c.Param("listId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid reading list ID",
+        })
+        return
+    }
+    postID, err := strconv.ParseUint(c.Param("postId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    if err := ctrl.bookmarkService.RemoveFromReadingList(userID, uint(listID), uint(postID)); err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Post removed from reading list",
+    })
+}
+
+// PUT /api/me/lists/:listId/order - Reorder a list's posts
+func (ctrl *BookmarkController) ReorderReadingList(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    listID, err := strconv.ParseUint(c.Param("listId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid reading list ID",
+        })
+        return
+    }
+
+    var req models.ReorderReadingListRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    if err := ctrl.bookmarkService.ReorderReadingList(userID, uint(listID), req); err != nil {
+        bookmarkError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Reading list reordered",
+    })
+}
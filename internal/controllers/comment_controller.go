@@ -3,6 +3,7 @@ package controllers
 import (
     "auth2_google/internal/services"
     "auth2_google/internal/models"
+    "errors"
     "github.com/gin-gonic/gin"
     "net/http"
     "strconv"
@@ -42,7 +43,14 @@ func (ctrl *CommentController) CreateComment(c *gin.Context) {
     // Set the blog post ID from URL parameter
     req.BlogPostID = uint(blogID)
 
-    comment, err := ctrl.commentService.CreateComment(req)
+    comment, err := ctrl.commentService.CreateComment(req, requestLocale(c))
+    if errors.Is(err, services.ErrPostNotFound) || errors.Is(err, services.ErrParentCommentNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -70,7 +78,7 @@ func (ctrl *CommentController) GetCommentsByBlog(c *gin.Context) {
         return
     }
 
-    comments, err := ctrl.commentService.GetCommentsByBlogPostID(uint(blogID))
+    comments, err := ctrl.commentService.GetCommentsByBlogPostID(uint(blogID), requestLocale(c))
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -97,7 +105,7 @@ func (ctrl *CommentController) GetComment(c *gin.Context) {
         return
     }
 
-    comment, err := ctrl.commentService.GetCommentByID(uint(commentID))
+    comment, err := ctrl.commentService.GetCommentByID(uint(commentID), requestLocale(c))
     if err != nil {
         c.JSON(http.StatusNotFound, gin.H{
             "success": false,
@@ -133,7 +141,7 @@ func (ctrl *CommentController) UpdateComment(c *gin.Context) {
         return
     }
 
-    comment, err := ctrl.commentService.UpdateComment(uint(commentID), req)
+    comment, err := ctrl.commentService.UpdateComment(uint(commentID), req, requestLocale(c))
     if err != nil {
         c.JSON(http.StatusNotFound, gin.H{
             "success": false,
@@ -197,7 +205,14 @@ func (ctrl *CommentController) CreateReply(c *gin.Context) {
         return
     }
 
-    reply, err := ctrl.commentService.CreateReply(uint(parentID), req)
+    reply, err := ctrl.commentService.CreateReply(uint(parentID), req, requestLocale(c))
+    if errors.Is(err, services.ErrPostNotFound) || errors.Is(err, services.ErrParentCommentNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -225,7 +240,7 @@ func (ctrl *CommentController) GetReplies(c *gin.Context) {
         return
     }
 
-    replies, err := ctrl.commentService.GetReplies(uint(parentID))
+    replies, err := ctrl.commentService.GetReplies(uint(parentID), requestLocale(c))
     if err != nil {
         c.JSON(http.StatusInternalServerError, gin.H{
             "success": false,
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "crypto/sha256"
+    "encoding/hex"
+    "errors"
+    "net/http"
+    "strconv"
+    "strings"
+    "time"
+
+    "github.com/gin-gonic/gin"
+)
+
+var feedContentTypes = map[string]string{
+    models.FeedRSS:  "application/rss+xml; charset=utf-8",
+    models.FeedAtom: "application/atom+xml; charset=utf-8",
+    models.FeedJSON: "application/feed+json; charset=utf-8",
+}
+
+type FeedController struct {
+    feedService services.FeedServiceInterface
+}
+
+func NewFeedController(feedService services.FeedServiceInterface) *FeedController {
+    return &FeedController{
+        feedService: feedService,
+    }
+}
+
+// Feed returns the handler for one format. It serves all of:
+//   GET /feeds/rss.xml, /feeds/tags/:slug/rss.xml, /feeds/authors/:id/rss.xml
+// and likewise atom.xml and feed.json. ?mode=full|excerpt picks the content mode.
+func (ctrl *FeedController) Feed(format string) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        filter := models.FeedFilter{TagSlug: c.Param("slug")}
+        if idStr := c.Param("id"); idStr != "" {
+            authorID, err := strconv.ParseUint(idStr, 10, 32)
+            if err != nil {
+                c.JSON(http.StatusBadRequest, gin.H{
+                    "success": false,
+                    "error":   "Invalid author ID",
+                })
+                return
+            }
+            filter.AuthorID = uint(authorID)
+        }
+
+        fullContent := config.FeedFullContent()
+        switch c.Query("mode") {
+        case "full":
+            fullContent = true
+        case "excerpt":
+            fullContent = false
+        }
+
+        body, lastModified, err := ctrl.feedService.BuildFeed(format, filter, fullContent)
+        if err != nil {
+            if errors.Is(err, services.ErrFeedNotFound) {
+                c.JSON(http.StatusNotFound, gin.H{
+                    "success": false,
+                    "error":   "Feed not found",
+                })
+                return
+            }
+            c.JSON(http.StatusInternalServerError, gin.H{
+                "success": false,
+                "error":   "Failed to build feed",
+            })
+            return
+        }
+
+        serveFeed(c, body, feedContentTypes[format], lastModified)
+    }
+}
+
+// serveFeed sends a feed with ETag and Last-Modified, answering 304 to
+// aggregators that already have the current version
+func serveFeed(c *gin.Context, body []byte, contentType string, lastModified time.Time) {
+    sum := sha256.Sum256(body)
+    etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+
+    c.Header("ETag", etag)
+    c.Header("Cache-Control", "public, max-age=300")
+    if !lastModified.IsZero() {
+        c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+    }
+
+    // If-None-Match wins over If-Modified-Since when both are sent
+    if match := c.GetHeader("If-None-Match"); match != "" {
+        for _, candidate := range strings.Split(match, ",") {
+            candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+            if candidate == etag || candidate == "*" {
+                c.Status(http.StatusNotModified)
+                return
+            }
+        }
+    } else if since, err := http.ParseTime(c.GetHeader("If-Modified-Since")); err == nil && !lastModified.IsZero() && !lastModified.Truncate(time.Second).After(since) {
+        c.Status(http.StatusNotModified)
+        return
+    }
+
+    c.Data(http.StatusOK, contentType, body)
+}
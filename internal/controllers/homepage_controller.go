@@ -0,0 +1,207 @@
+package controllers
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type HomepageController struct {
+    homepageService services.HomepageServiceInterface
+}
+
+func NewHomepageController(homepageService services.HomepageServiceInterface) *HomepageController {
+    return &HomepageController{
+        homepageService: homepageService,
+    }
+}
+
+// GET /api/posts/featured?limit=... - Currently featured posts by position
+func (ctrl *HomepageController) GetFeaturedPosts(c *gin.Context) {
+    limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+    if err != nil || limit < 1 || limit > 50 {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Limit must be between 1 and 50",
+        })
+        return
+    }
+
+    posts, err := ctrl.homepageService.GetFeaturedPosts(limit, readOptions(c))
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get featured posts",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "posts":   posts,
+    })
+}
+
+// PUT /api/posts/:id/featured - Feature a post, with an optional position and expiry
+func (ctrl *HomepageController) FeaturePost(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    var req models.FeaturePostRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    featured, err := ctrl.homepageService.FeaturePost(uint(id), req)
+    if errors.Is(err, services.ErrPostNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":  true,
+        "message":  "Post featured",
+        "featured": featured,
+    })
+}
+
+// DELETE /api/posts/:id/featured
+func (ctrl *HomepageController) UnfeaturePost(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    if err := ctrl.homepageService.UnfeaturePost(uint(id)); err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to unfeature post",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Post is no longer featured",
+    })
+}
+
+// GET /api/homepage - Homepage slots in page order, each with its posts
+func (ctrl *HomepageController) GetHomepage(c *gin.Context) {
+    slots, err := ctrl.homepageService.GetHomepage(readOptions(c))
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get homepage",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "slots":   slots,
+    })
+}
+
+// GET /api/homepage/slots - Slot settings for editors
+func (ctrl *HomepageController) GetSlots(c *gin.Context) {
+    slots, err := ctrl.homepageService.GetSlots()
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get homepage slots",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "slots":   slots,
+    })
+}
+
+// PUT /api/homepage/slots/:name - Create or replace a slot
+func (ctrl *HomepageController) SaveSlot(c *gin.Context) {
+    var req models.SaveHomepageSlotRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    slot, err := ctrl.homepageService.SaveSlot(c.Param("name"), req)
+    if errors.Is(err, services.ErrPostNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Homepage slot saved",
+        "slot":    slot,
+    })
+}
+
+// DELETE /api/homepage/slots/:name
+func (ctrl *HomepageController) DeleteSlot(c *gin.Context) {
+    err := ctrl.homepageService.DeleteSlot(c.Param("name"))
+    if errors.Is(err, services.ErrSlotNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Homepage slot not found",
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to delete homepage slot",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Homepage slot deleted",
+    })
+}
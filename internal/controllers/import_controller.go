@@ -0,0 +1,72 @@
+package controllers
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/services"
+    "errors"
+    "fmt"
+    "net/http"
+
+    "github.com/gin-gonic/gin"
+)
+
+type ImportController struct {
+    importService services.ImportServiceInterface
+}
+
+func NewImportController(importService services.ImportServiceInterface) *ImportController {
+    return &ImportController{
+        importService: importService,
+    }
+}
+
+// POST /api/admin/import/wordpress - Import a WordPress WXR export (multipart form field "file")
+func (ctrl *ImportController) ImportWordPress(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+
+    maxBytes := config.ImportMaxBytes()
+    c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+1<<20)
+
+    file, _, err := c.Request.FormFile("file")
+    if err != nil {
+        var maxBytesErr *http.MaxBytesError
+        if errors.As(err, &maxBytesErr) {
+            c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+                "success": false,
+                "error":   fmt.Sprintf("Export file must be at most %d MB", maxBytes>>20),
+            })
+            return
+        }
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "A WXR file is required in the \"file\" field",
+        })
+        return
+    }
+    defer file.Close()
+
+    // Downloaded images belong to the admin running the import
+    report, err := ctrl.importService.ImportWordPress(c.Request.Context(), file, userID)
+    if err != nil && report == nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        // Cancelled part way, what was imported stays and a rerun picks up the rest
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   err.Error(),
+            "report":  report,
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "report":  report,
+    })
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+    "auth2_google/internal/services"
+    "bytes"
+    "html/template"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+// Crawlers don't run JavaScript, so they read the tags here. People who open
+// the link are sent on to the real page.
+var shareTemplate = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="{{.Language}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<meta name="description" content="{{.Description}}">
+<link rel="canonical" href="{{.URL}}">
+{{range .OpenGraph}}<meta property="{{.Key}}" content="{{.Content}}">
+{{end}}{{range .Twitter}}<meta name="{{.Key}}" content="{{.Content}}">
+{{end}}<script type="application/ld+json">{{.JSONLD}}</script>
+<script>window.location.replace({{.URL}});</script>
+</head>
+<body>
+<p><a href="{{.URL}}">{{.Title}}</a></p>
+</body>
+</html>
+`))
+
+type MetaController struct {
+    metaService services.MetaServiceInterface
+}
+
+func NewMetaController(metaService services.MetaServiceInterface) *MetaController {
+    return &MetaController{
+        metaService: metaService,
+    }
+}
+
+// GET /api/posts/:id/meta - Open Graph, Twitter Card and JSON-LD data for a post
+func (ctrl *MetaController) GetPostMeta(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    meta, err := ctrl.metaService.GetPostMeta(uint(id), readOptions(c))
+    if err != nil {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "meta":    meta,
+    })
+}
+
+// GET /share/posts/:id - HTML page with the share tags, for crawlers
+func (ctrl *MetaController) SharePage(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.String(http.StatusBadRequest, "Invalid post ID")
+        return
+    }
+
+    meta, err := ctrl.metaService.GetPostMeta(uint(id), readOptions(c))
+    if err != nil {
+        c.String(http.StatusNotFound, "Post not found")
+        return
+    }
+
+    var page bytes.Buffer
+    if err := shareTemplate.Execute(&page, meta); err != nil {
+        c.String(http.StatusInternalServerError, "Failed to render page")
+        return
+    }
+
+    c.Header("Cache-Control", "public, max-age=300")
+    c.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+}
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type PodcastController struct {
+    podcastService services.PodcastServiceInterface
+    audioService   services.AudioServiceInterface
+}
+
+func NewPodcastController(podcastService services.PodcastServiceInterface, audioService services.AudioServiceInterface) *PodcastController {
+    return &PodcastController{
+        podcastService: podcastService,
+        audioService:   audioService,
+    }
+}
+
+// GET /feeds/podcast.xml - Podcast RSS feed of posts with audio
+func (ctrl *PodcastController) GetFeed(c *gin.Context) {
+    body, lastModified, err := ctrl.podcastService.BuildFeed()
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to build podcast feed",
+        })
+        return
+    }
+
+    // Podcast apps poll often, let them skip unchanged feeds
+    serveFeed(c, body, "application/rss+xml; charset=utf-8", lastModified)
+}
+
+// GET /api/admin/podcast - Channel settings
+func (ctrl *PodcastController) GetSettings(c *gin.Context) {
+    settings, err := ctrl.podcastService.GetSettings()
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get podcast settings",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":  true,
+        "settings": settings,
+    })
+}
+
+// PUT /api/admin/podcast - Update channel settings (title, artwork, explicit flag, ...)
+func (ctrl *PodcastController) UpdateSettings(c *gin.Context) {
+    var req models.UpdatePodcastSettingsRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    settings, err := ctrl.podcastService.UpdateSettings(req)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":  true,
+        "message":  "Podcast settings updated successfully",
+        "settings": settings,
+    })
+}
+
+// PUT /api/posts/:id/audio/episode - Episode number, season, explicit flag and type
+func (ctrl *PodcastController) UpdateEpisode(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    var req models.UpdateEpisodeRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    audio, err := ctrl.audioService.UpdateEpisode(uint(postID), userID, req)
+    if errors.Is(err, services.ErrPostForbidden) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Episode updated successfully",
+        "audio":   audio,
+    })
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type PreviewController struct {
+    previewService services.PreviewServiceInterface
+}
+
+func NewPreviewController(previewService services.PreviewServiceInterface) *PreviewController {
+    return &PreviewController{
+        previewService: previewService,
+    }
+}
+
+// POST /api/posts/:id/preview-links - Shareable link to a draft
+func (ctrl *PreviewController) CreatePreviewLink(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    // The body is optional
+    var req models.CreatePreviewLinkRequest
+    if c.Request.ContentLength > 0 {
+        if err := c.ShouldBindJSON(&req); err != nil {
+            c.JSON(http.StatusBadRequest, gin.H{
+                "success": false,
+                "error":   "Invalid input: " + err.Error(),
+            })
+            return
+        }
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    link, err := ctrl.previewService.CreatePreviewLink(uint(id), userID, req)
+    if errors.Is(err, services.ErrPostNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+    if errors.Is(err, services.ErrPostForbidden) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success": true,
+        "preview": link,
+    })
+}
+
+// DELETE /api/posts/:id/preview-links - Revoke every preview link for the post
+func (ctrl *PreviewController) RevokePreviewLinks(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    err = ctrl.previewService.RevokePreviewLinks(uint(id), userID)
+    if errors.Is(err, services.ErrPostNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+    if errors.Is(err, services.ErrPostForbidden) {
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to revoke preview links",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "All preview links for this post were revoked",
+    })
+}
+
+// GET /api/preview/:token - The draft behind a preview link, no sign-in needed
+func (ctrl *PreviewController) GetPreview(c *gin.Context) {
+    // Drafts must not be cached or indexed
+    c.Header("Cache-Control", "no-store")
+    c.Header("X-Robots-Tag", "noindex, nofollow")
+
+    post, err := ctrl.previewService.GetPreview(c.Param("token"), readOptions(c))
+    if errors.Is(err, services.ErrInvalidPreview) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get preview",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "post":    post,
+    })
+}
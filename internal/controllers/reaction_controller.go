@@ -0,0 +1,101 @@
+package controllers
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+    "strings"
+
+    "github.com/gin-gonic/gin"
+)
+
+// Anonymous readers identify themselves with a random ID kept by the frontend
+const fingerprintHeader = "X-Reader-Fingerprint"
+
+type ReactionController struct {
+    reactionService services.ReactionServiceInterface
+}
+
+func NewReactionController(reactionService services.ReactionServiceInterface) *ReactionController {
+    return &ReactionController{
+        reactionService: reactionService,
+    }
+}
+
+// GET /api/posts/:id/reactions - Counts, plus the caller's own reaction
+func (ctrl *ReactionController) GetReactions(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    reactions, err := ctrl.reactionService.GetReactions(uint(postID), optionalUserID(c), c.GetHeader(fingerprintHeader))
+    ctrl.respond(c, reactions, err)
+}
+
+// PUT /api/posts/:id/reactions/:reaction - React, replacing any earlier reaction
+func (ctrl *ReactionController) AddReaction(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    reaction := strings.ToLower(c.Param("reaction"))
+    reactions, err := ctrl.reactionService.AddReaction(uint(postID), reaction, optionalUserID(c), c.GetHeader(fingerprintHeader))
+    ctrl.respond(c, reactions, err)
+}
+
+// DELETE /api/posts/:id/reactions/:reaction
+func (ctrl *ReactionController) RemoveReaction(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    reaction := strings.ToLower(c.Param("reaction"))
+    reactions, err := ctrl.reactionService.RemoveReaction(uint(postID), reaction, optionalUserID(c), c.GetHeader(fingerprintHeader))
+    ctrl.respond(c, reactions, err)
+}
+
+func (ctrl *ReactionController) respond(c *gin.Context, reactions *models.ReactionsResponse, err error) {
+    if err != nil {
+        switch {
+        case errors.Is(err, services.ErrPostNotFound):
+            c.JSON(http.StatusNotFound, gin.H{
+                "success": false,
+                "error":   "Post not found",
+            })
+        case errors.Is(err, services.ErrUnknownReaction), errors.Is(err, services.ErrReaderRequired):
+            c.JSON(http.StatusBadRequest, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+        default:
+            c.JSON(http.StatusInternalServerError, gin.H{
+                "success": false,
+                "error":   "Failed to update reactions",
+            })
+        }
+        return
+    }
+
+    c.Header("Cache-Control", "no-store")
+    c.JSON(http.StatusOK, gin.H{
+        "success":   true,
+        "reactions": reactions,
+    })
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/i18n"
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "strings"
+
+    "github.com/gin-gonic/gin"
+)
+
+// requestLanguage is the language the reader asked for: ?lang= first, then
+// Accept-Language. "" means no preference, posts come back in their own language.
+func requestLanguage(c *gin.Context) string {
+    c.Header("Vary", "Accept-Language")
+
+    if lang := strings.ToLower(c.Query("lang")); config.IsSupportedLanguage(lang) {
+        return lang
+    }
+    return i18n.Negotiate(c.GetHeader("Accept-Language"), config.SupportedLanguages())
+}
+
+// requestLocale picks the locale for formatted dates the same way. Finnish
+// readers get Finnish dates even though posts aren't written in Finnish.
+func requestLocale(c *gin.Context) string {
+    c.Header("Vary", "Accept-Language")
+
+    lang := strings.ToLower(c.Query("lang"))
+    for _, locale := range i18n.Locales {
+        if lang == locale {
+            return locale
+        }
+    }
+    if locale := i18n.Negotiate(c.GetHeader("Accept-Language"), i18n.Locales); locale != "" {
+        return locale
+    }
+    return config.DateLocale()
+}
+
+// The signed-in user, if any
+func optionalUserID(c *gin.Context) *uint {
+    if userID, ok := middleware.CurrentUserID(c); ok {
+        return &userID
+    }
+    return nil
+}
+
+func readOptions(c *gin.Context) models.ReadOptions {
+    return models.ReadOptions{
+        Language: requestLanguage(c),
+        Locale:   requestLocale(c),
+        UserID:   optionalUserID(c),
+    }
+}
@@ -0,0 +1,62 @@
+package controllers
+
+import (
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type RelatedController struct {
+    relatedService services.RelatedServiceInterface
+}
+
+func NewRelatedController(relatedService services.RelatedServiceInterface) *RelatedController {
+    return &RelatedController{
+        relatedService: relatedService,
+    }
+}
+
+// GET /api/posts/:id/related?limit=... - Posts to read next, most similar first
+func (ctrl *RelatedController) GetRelated(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    limit, err := strconv.Atoi(c.DefaultQuery("limit", "5"))
+    if err != nil || limit < 1 || limit > 20 {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Limit must be between 1 and 20",
+        })
+        return
+    }
+
+    posts, err := ctrl.relatedService.GetRelated(uint(id), limit, readOptions(c))
+    if errors.Is(err, services.ErrPostNotFound) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get related posts",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "posts":   posts,
+    })
+}
@@ -0,0 +1,236 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type ReviewController struct {
+    reviewService services.ReviewServiceInterface
+}
+
+func NewReviewController(reviewService services.ReviewServiceInterface) *ReviewController {
+    return &ReviewController{
+        reviewService: reviewService,
+    }
+}
+
+// Map service errors onto responses for every handler here
+func reviewError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, services.ErrPostNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+    case errors.Is(err, services.ErrReviewNoteNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Review note not found",
+        })
+    case errors.Is(err, services.ErrReviewForbidden), errors.Is(err, services.ErrPostForbidden):
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+    default:
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+    }
+}
+
+// Parse a numeric route parameter, answering 400 when it isn't one
+func reviewParamID(c *gin.Context, param, label string) (uint, bool) {
+    id, err := strconv.ParseUint(c.Param(param), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid " + label + " ID",
+        })
+        return 0, false
+    }
+    return uint(id), true
+}
+
+// GET /api/posts/:id/review - Review status, reviewers and notes (authors and editors only)
+func (ctrl *ReviewController) GetReview(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    postID, ok := reviewParamID(c, "id", "post")
+    if !ok {
+        return
+    }
+
+    review, err := ctrl.reviewService.GetReview(postID, userID)
+    if err != nil {
+        reviewError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "review":  review,
+    })
+}
+
+// PUT /api/posts/:id/review/status - Submit, withdraw, start reviewing, request changes or approve
+func (ctrl *ReviewController) Transition(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    postID, ok := reviewParamID(c, "id", "post")
+    if !ok {
+        return
+    }
+
+    var req models.ReviewTransitionRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    review, err := ctrl.reviewService.Transition(postID, userID, req)
+    if err != nil {
+        reviewError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "review":  review,
+    })
+}
+
+// PUT /api/posts/:id/reviewers - Assign the editors who review the post
+func (ctrl *ReviewController) AssignReviewers(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    postID, ok := reviewParamID(c, "id", "post")
+    if !ok {
+        return
+    }
+
+    var req models.AssignReviewersRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    review, err := ctrl.reviewService.AssignReviewers(postID, userID, req)
+    if err != nil {
+        reviewError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "review":  review,
+    })
+}
+
+// GET /api/review-queue?assigned=me - Posts waiting for an editor, longest waiting first
+func (ctrl *ReviewController) GetQueue(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+
+    posts, err := ctrl.reviewService.GetQueue(userID, c.Query("assigned") == "me")
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get review queue",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "posts":   posts,
+        "count":   len(posts),
+    })
+}
+
+// POST /api/posts/:id/review/notes - Leave a review note, optionally anchored to a text range
+func (ctrl *ReviewController) AddNote(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    postID, ok := reviewParamID(c, "id", "post")
+    if !ok {
+        return
+    }
+
+    var req models.CreateReviewNoteRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    note, err := ctrl.reviewService.AddNote(postID, userID, req)
+    if err != nil {
+        reviewError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success": true,
+        "note":    note,
+    })
+}
+
+// PUT /api/review-notes/:noteId - Mark a note resolved or reopen it
+func (ctrl *ReviewController) ResolveNote(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    noteID, ok := reviewParamID(c, "noteId", "note")
+    if !ok {
+        return
+    }
+
+    var req models.ResolveReviewNoteRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    note, err := ctrl.reviewService.ResolveNote(noteID, userID, req.Resolved)
+    if err != nil {
+        reviewError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "note":    note,
+    })
+}
+
+// DELETE /api/review-notes/:noteId - Delete a note (its writer or an admin)
+func (ctrl *ReviewController) DeleteNote(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    noteID, ok := reviewParamID(c, "noteId", "note")
+    if !ok {
+        return
+    }
+
+    if err := ctrl.reviewService.DeleteNote(noteID, userID); err != nil {
+        reviewError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Review note deleted",
+    })
+}
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type SeriesController struct {
+    seriesService services.SeriesServiceInterface
+}
+
+func NewSeriesController(seriesService services.SeriesServiceInterface) *SeriesController {
+    return &SeriesController{
+        seriesService: seriesService,
+    }
+}
+
+func seriesError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, services.ErrSeriesNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Series not found",
+        })
+    case errors.Is(err, services.ErrPostNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+    default:
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+    }
+}
+
+// GET /api/series
+func (ctrl *SeriesController) GetAllSeries(c *gin.Context) {
+    series, err := ctrl.seriesService.GetAllSeries()
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get series",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "series":  series,
+    })
+}
+
+// GET /api/series/:slug - Series landing page with its published parts in order
+func (ctrl *SeriesController) GetSeries(c *gin.Context) {
+    series, err := ctrl.seriesService.GetSeries(c.Param("slug"), readOptions(c))
+    if err != nil {
+        if errors.Is(err, services.ErrSeriesNotFound) {
+            seriesError(c, err)
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get series",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "series":  series,
+    })
+}
+
+// POST /api/series
+func (ctrl *SeriesController) CreateSeries(c *gin.Context) {
+    var req models.SaveSeriesRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    series, err := ctrl.seriesService.CreateSeries(req)
+    if err != nil {
+        seriesError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success": true,
+        "message": "Series created successfully",
+        "series":  series,
+    })
+}
+
+// PUT /api/series/:id
+func (ctrl *SeriesController) UpdateSeries(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid series ID",
+        })
+        return
+    }
+
+    var req models.SaveSeriesRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    series, err := ctrl.seriesService.UpdateSeries(uint(id), req)
+    if err != nil {
+        seriesError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Series updated successfully",
+        "series":  series,
+    })
+}
+
+// DELETE /api/series/:id - The posts themselves are kept
+func (ctrl *SeriesController) DeleteSeries(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid series ID",
+        })
+        return
+    }
+
+    if err := ctrl.seriesService.DeleteSeries(uint(id)); err != nil {
+        seriesError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Series deleted successfully",
+    })
+}
+
+// PUT /api/series/:id/posts - Set the parts, part 1 first
+func (ctrl *SeriesController) SetSeriesPosts(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid series ID",
+        })
+        return
+    }
+
+    var req models.SetSeriesPostsRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    series, err := ctrl.seriesService.SetSeriesPosts(uint(id), req)
+    if err != nil {
+        seriesError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Series posts updated successfully",
+        "series":  series,
+    })
+}
@@ -0,0 +1,65 @@
+package controllers
+
+import (
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+    "strings"
+
+    "github.com/gin-gonic/gin"
+)
+
+type SitemapController struct {
+    sitemapService services.SitemapServiceInterface
+}
+
+func NewSitemapController(sitemapService services.SitemapServiceInterface) *SitemapController {
+    return &SitemapController{
+        sitemapService: sitemapService,
+    }
+}
+
+// GET /sitemap.xml - All published posts, or a sitemap index on large sites
+func (ctrl *SitemapController) GetSitemap(c *gin.Context) {
+    ctrl.serveSitemap(c, 0)
+}
+
+// GET /sitemaps/:file - One page of the sitemap index (posts-1.xml, posts-2.xml, ...)
+func (ctrl *SitemapController) GetSitemapPage(c *gin.Context) {
+    file := c.Param("file")
+    page, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(file, "posts-"), ".xml"))
+    if err != nil || page < 1 || !strings.HasPrefix(file, "posts-") || !strings.HasSuffix(file, ".xml") {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Sitemap not found",
+        })
+        return
+    }
+    ctrl.serveSitemap(c, page)
+}
+
+func (ctrl *SitemapController) serveSitemap(c *gin.Context, page int) {
+    body, lastModified, err := ctrl.sitemapService.BuildSitemap(page)
+    if err != nil {
+        if errors.Is(err, services.ErrSitemapNotFound) {
+            c.JSON(http.StatusNotFound, gin.H{
+                "success": false,
+                "error":   "Sitemap not found",
+            })
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to build sitemap",
+        })
+        return
+    }
+
+    serveFeed(c, body, "application/xml; charset=utf-8", lastModified)
+}
+
+// GET /robots.txt
+func (ctrl *SitemapController) GetRobots(c *gin.Context) {
+    c.String(http.StatusOK, ctrl.sitemapService.RobotsTxt())
+}
@@ -0,0 +1,296 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "fmt"
+    "io"
+    "mime"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type SubmissionController struct {
+    submissionService services.SubmissionServiceInterface
+}
+
+func NewSubmissionController(submissionService services.SubmissionServiceInterface) *SubmissionController {
+    return &SubmissionController{
+        submissionService: submissionService,
+    }
+}
+
+// Map service errors onto responses for every handler here
+func submissionError(c *gin.Context, err error) {
+    switch {
+    case errors.Is(err, services.ErrSubmissionNotFound):
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Submission not found",
+        })
+    case errors.Is(err, services.ErrTooManySubmissions):
+        c.JSON(http.StatusTooManyRequests, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+    case errors.Is(err, services.ErrUnsupportedMediaType):
+        c.JSON(http.StatusUnsupportedMediaType, gin.H{
+            "success": false,
+            "error":   "Attachments must be images, PDF, plain text, DOCX or ODT files",
+        })
+    default:
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+    }
+}
+
+func (ctrl *SubmissionController) fileTooLarge(c *gin.Context) {
+    c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+        "success": false,
+        "error":   fmt.Sprintf("Each attachment must be at most %d MB", ctrl.submissionService.MaxAttachmentBytes()>>20),
+    })
+}
+
+// POST /api/submissions - Pitch an article without an account (multipart form, files in "attachments")
+func (ctrl *SubmissionController) Submit(c *gin.Context) {
+    maxBytes := ctrl.submissionService.MaxAttachmentBytes()
+    // Room for every attachment plus the text fields
+    c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, int64(ctrl.submissionService.MaxAttachments())*maxBytes+2<<20)
+
+    var req models.SubmissionRequest
+    if err := c.ShouldBind(&req); err != nil {
+        var maxBytesErr *http.MaxBytesError
+        if errors.As(err, &maxBytesErr) {
+            ctrl.fileTooLarge(c)
+            return
+        }
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    files := []services.SubmissionFile{}
+    if form, err := c.MultipartForm(); err == nil {
+        headers := form.File["attachments"]
+        if len(headers) > ctrl.submissionService.MaxAttachments() {
+            c.JSON(http.StatusBadRequest, gin.H{
+                "success": false,
+                "error":   fmt.Sprintf("At most %d attachments are allowed", ctrl.submissionService.MaxAttachments()),
+            })
+            return
+        }
+        for _, header := range headers {
+            if header.Size > maxBytes {
+                ctrl.fileTooLarge(c)
+                return
+            }
+            file, err := header.Open()
+            if err != nil {
+                c.JSON(http.StatusBadRequest, gin.H{
+                    "success": false,
+                    "error":   "Failed to read attachment",
+                })
+                return
+            }
+            data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+            file.Close()
+            if err != nil {
+                c.JSON(http.StatusBadRequest, gin.H{
+                    "success": false,
+                    "error":   "Failed to read attachment",
+                })
+                return
+            }
+            files = append(files, services.SubmissionFile{Filename: header.Filename, Data: data})
+        }
+    }
+
+    receipt, err := ctrl.submissionService.Submit(c.Request.Context(), req, files, c.ClientIP())
+    if err != nil {
+        if errors.Is(err, services.ErrFileTooLarge) {
+            ctrl.fileTooLarge(c)
+            return
+        }
+        submissionError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success":    true,
+        "message":    "Thanks! Keep the status link to follow your submission.",
+        "submission": receipt,
+    })
+}
+
+// GET /api/submissions/status/:token - The guest's view of their submission
+func (ctrl *SubmissionController) GetStatus(c *gin.Context) {
+    c.Header("Cache-Control", "no-store")
+    c.Header("X-Robots-Tag", "noindex, nofollow")
+
+    status, err := ctrl.submissionService.GetStatus(c.Param("token"))
+    if err != nil {
+        submissionError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":    true,
+        "submission": status,
+    })
+}
+
+// GET /api/submissions?status=pending - Submissions for editors, newest first
+func (ctrl *SubmissionController) GetSubmissions(c *gin.Context) {
+    submissions, err := ctrl.submissionService.GetSubmissions(c.Query("status"))
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get submissions",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":     true,
+        "submissions": submissions,
+        "count":       len(submissions),
+    })
+}
+
+// GET /api/submissions/:id - One submission with its full text
+func (ctrl *SubmissionController) GetSubmission(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid submission ID",
+        })
+        return
+    }
+
+    submission, err := ctrl.submissionService.GetSubmission(uint(id))
+    if err != nil {
+        submissionError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":    true,
+        "submission": submission,
+    })
+}
+
+// GET /api/submissions/:id/attachments/:attachmentId - Download an attachment
+func (ctrl *SubmissionController) DownloadAttachment(c *gin.Context) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid submission ID",
+        })
+        return
+    }
+    attachmentID, err := strconv.ParseUint(c.Param("attachmentId"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid attachment ID",
+        })
+        return
+    }
+
+    file, attachment, err := ctrl.submissionService.OpenAttachment(c.Request.Context(), uint(id), uint(attachmentID))
+    if err != nil {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+    defer file.Close()
+
+    // Always a download, never rendered inline in the admin's browser
+    c.Header("Content-Type", attachment.ContentType)
+    c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.OriginalName}))
+    c.Header("X-Content-Type-Options", "nosniff")
+    c.Header("Cache-Control", "private, no-store")
+    http.ServeContent(c.Writer, c.Request, "", attachment.CreatedAt, file)
+}
+
+// PUT /api/submissions/:id/status - Mark as pending, reviewing or rejected, with a message for the guest
+func (ctrl *SubmissionController) SetStatus(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid submission ID",
+        })
+        return
+    }
+
+    var req models.SubmissionStatusRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    submission, err := ctrl.submissionService.SetStatus(uint(id), userID, req)
+    if err != nil {
+        submissionError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":    true,
+        "submission": submission,
+    })
+}
+
+// POST /api/submissions/:id/accept - Accept and turn the submission into a draft post
+func (ctrl *SubmissionController) Accept(c *gin.Context) {
+    userID, _ := middleware.CurrentUserID(c)
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid submission ID",
+        })
+        return
+    }
+
+    // The body is optional
+    var req models.AcceptSubmissionRequest
+    if c.Request.ContentLength > 0 {
+        if err := c.ShouldBindJSON(&req); err != nil {
+            c.JSON(http.StatusBadRequest, gin.H{
+                "success": false,
+                "error":   "Invalid input: " + err.Error(),
+            })
+            return
+        }
+    }
+
+    submission, err := ctrl.submissionService.Accept(uint(id), userID, req)
+    if err != nil {
+        submissionError(c, err)
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success":    true,
+        "message":    "Submission accepted as a draft post",
+        "submission": submission,
+    })
+}
@@ -0,0 +1,174 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/models"
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+    "strings"
+
+    "github.com/gin-gonic/gin"
+)
+
+type TranslationController struct {
+    translationService services.TranslationServiceInterface
+}
+
+func NewTranslationController(translationService services.TranslationServiceInterface) *TranslationController {
+    return &TranslationController{
+        translationService: translationService,
+    }
+}
+
+// GET /api/posts/:id/languages - Languages the post is published in
+func (ctrl *TranslationController) GetLanguages(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    languages, err := ctrl.translationService.GetLanguages(uint(postID), optionalUserID(c))
+    if err != nil {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "Post not found",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":   true,
+        "languages": languages,
+    })
+}
+
+// GET /api/posts/:id/translations - All translations including drafts, for editors
+func (ctrl *TranslationController) GetTranslations(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    translations, err := ctrl.translationService.GetTranslations(uint(postID), userID)
+    if err != nil {
+        if errors.Is(err, services.ErrPostNotFound) {
+            c.JSON(http.StatusNotFound, gin.H{
+                "success": false,
+                "error":   "Post not found",
+            })
+            return
+        }
+        if errors.Is(err, services.ErrPostForbidden) {
+            c.JSON(http.StatusForbidden, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get translations",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":      true,
+        "translations": translations,
+    })
+}
+
+// PUT /api/posts/:id/translations/:lang - Create or replace a translation
+func (ctrl *TranslationController) SaveTranslation(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    var req models.SaveTranslationRequest
+    if err := c.ShouldBindJSON(&req); err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid input: " + err.Error(),
+        })
+        return
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    translation, err := ctrl.translationService.SaveTranslation(uint(postID), userID, strings.ToLower(c.Param("lang")), req)
+    if err != nil {
+        status := http.StatusBadRequest
+        if errors.Is(err, services.ErrPostNotFound) {
+            status = http.StatusNotFound
+        } else if errors.Is(err, services.ErrPostForbidden) || errors.Is(err, services.ErrNeedsApproval) {
+            status = http.StatusForbidden
+        }
+        c.JSON(status, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success":     true,
+        "message":     "Translation saved successfully",
+        "translation": translation,
+    })
+}
+
+// DELETE /api/posts/:id/translations/:lang
+func (ctrl *TranslationController) DeleteTranslation(c *gin.Context) {
+    postID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid post ID",
+        })
+        return
+    }
+
+    userID, _ := middleware.CurrentUserID(c)
+    err = ctrl.translationService.DeleteTranslation(uint(postID), userID, strings.ToLower(c.Param("lang")))
+    if err != nil {
+        if errors.Is(err, services.ErrTranslationNotFound) || errors.Is(err, services.ErrPostNotFound) {
+            c.JSON(http.StatusNotFound, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+            return
+        }
+        if errors.Is(err, services.ErrPostForbidden) {
+            c.JSON(http.StatusForbidden, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+            return
+        }
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to delete translation",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": "Translation deleted successfully",
+    })
+}
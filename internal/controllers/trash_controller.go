@@ -0,0 +1,92 @@
+package controllers
+
+import (
+    "auth2_google/internal/services"
+    "errors"
+    "net/http"
+    "strconv"
+
+    "github.com/gin-gonic/gin"
+)
+
+type TrashController struct {
+    trashService services.TrashServiceInterface
+}
+
+func NewTrashController(trashService services.TrashServiceInterface) *TrashController {
+    return &TrashController{
+        trashService: trashService,
+    }
+}
+
+// Every trash action takes an ID and answers the same way, only the action and wording differ
+func (ctrl *TrashController) handle(c *gin.Context, label, message string, action func(id uint) error) {
+    id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Invalid " + label + " ID",
+        })
+        return
+    }
+
+    err = action(uint(id))
+    if errors.Is(err, services.ErrNotInTrash) {
+        c.JSON(http.StatusNotFound, gin.H{
+            "success": false,
+            "error":   "No deleted " + label + " with that ID",
+        })
+        return
+    }
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   err.Error(),
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "message": message,
+    })
+}
+
+// GET /api/admin/trash - Deleted posts and comments, most recently deleted first
+func (ctrl *TrashController) GetTrash(c *gin.Context) {
+    trash, err := ctrl.trashService.GetTrash()
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get trash",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "trash":   trash,
+    })
+}
+
+// POST /api/admin/trash/posts/:id/restore
+func (ctrl *TrashController) RestorePost(c *gin.Context) {
+    ctrl.handle(c, "post", "Post restored", ctrl.trashService.RestorePost)
+}
+
+// POST /api/admin/trash/comments/:id/restore
+func (ctrl *TrashController) RestoreComment(c *gin.Context) {
+    ctrl.handle(c, "comment", "Comment restored", ctrl.trashService.RestoreComment)
+}
+
+// DELETE /api/admin/trash/posts/:id - Delete permanently, with its comments
+func (ctrl *TrashController) PurgePost(c *gin.Context) {
+    ctrl.handle(c, "post", "Post permanently deleted", func(id uint) error {
+        return ctrl.trashService.PurgePost(c.Request.Context(), id)
+    })
+}
+
+// DELETE /api/admin/trash/comments/:id - Delete permanently, with its replies
+func (ctrl *TrashController) PurgeComment(c *gin.Context) {
+    ctrl.handle(c, "comment", "Comment permanently deleted", ctrl.trashService.PurgeComment)
+}
@@ -0,0 +1,125 @@
+package controllers
+
+import (
+    "auth2_google/internal/middleware"
+    "auth2_google/internal/services"
+    "errors"
+    "fmt"
+    "io"
+    "net/http"
+
+    "github.com/gin-gonic/gin"
+)
+
+type UploadController struct {
+    uploadService services.UploadServiceInterface
+}
+
+func NewUploadController(uploadService services.UploadServiceInterface) *UploadController {
+    return &UploadController{
+        uploadService: uploadService,
+    }
+}
+
+// POST /api/uploads - Upload an image (multipart form field "file")
+func (ctrl *UploadController) UploadImage(c *gin.Context) {
+    userID, ok := middleware.CurrentUserID(c)
+    if !ok {
+        c.JSON(http.StatusUnauthorized, gin.H{
+            "success": false,
+            "error":   "Authentication required",
+        })
+        return
+    }
+
+    maxBytes := ctrl.uploadService.MaxImageBytes()
+    // Leave some room for the multipart headers around the file
+    c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes+1<<20)
+
+    file, header, err := c.Request.FormFile("file")
+    if err != nil {
+        var maxBytesErr *http.MaxBytesError
+        if errors.As(err, &maxBytesErr) {
+            ctrl.fileTooLarge(c, maxBytes)
+            return
+        }
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "A file is required in the \"file\" field",
+        })
+        return
+    }
+    defer file.Close()
+
+    data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+    if err != nil {
+        c.JSON(http.StatusBadRequest, gin.H{
+            "success": false,
+            "error":   "Failed to read uploaded file",
+        })
+        return
+    }
+
+    media, err := ctrl.uploadService.UploadImage(c.Request.Context(), userID, header.Filename, data)
+    if err != nil {
+        switch {
+        case errors.Is(err, services.ErrFileTooLarge):
+            ctrl.fileTooLarge(c, maxBytes)
+        case errors.Is(err, services.ErrUnsupportedMediaType):
+            c.JSON(http.StatusUnsupportedMediaType, gin.H{
+                "success": false,
+                "error":   "Only JPEG, PNG, GIF and WebP images are allowed",
+            })
+        case errors.Is(err, services.ErrImageTooLarge):
+            c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+                "success": false,
+                "error":   "Image must be at most 50 megapixels",
+            })
+        default:
+            c.JSON(http.StatusInternalServerError, gin.H{
+                "success": false,
+                "error":   err.Error(),
+            })
+        }
+        return
+    }
+
+    c.JSON(http.StatusCreated, gin.H{
+        "success": true,
+        "message": "File uploaded successfully",
+        "media":   media,
+    })
+}
+
+// GET /api/uploads - List the current user's media library
+func (ctrl *UploadController) GetMyMedia(c *gin.Context) {
+    userID, ok := middleware.CurrentUserID(c)
+    if !ok {
+        c.JSON(http.StatusUnauthorized, gin.H{
+            "success": false,
+            "error":   "Authentication required",
+        })
+        return
+    }
+
+    media, err := ctrl.uploadService.GetUserMedia(userID)
+    if err != nil {
+        c.JSON(http.StatusInternalServerError, gin.H{
+            "success": false,
+            "error":   "Failed to get media",
+        })
+        return
+    }
+
+    c.JSON(http.StatusOK, gin.H{
+        "success": true,
+        "media":   media,
+    })
+}
+
+func (ctrl *UploadController) fileTooLarge(c *gin.Context, maxBytes int64) {
+    c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+        "success": false,
+        "error":   fmt.Sprintf("File must be at most %d MB", maxBytes>>20),
+    })
+}
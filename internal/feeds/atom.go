@@ -0,0 +1,49 @@
+package feeds
+
+import "encoding/xml"
+
+// Atom 1.0 (RFC 4287)
+
+type AtomFeed struct {
+    XMLName  xml.Name    `xml:"feed"`
+    XMLNS    string      `xml:"xmlns,attr"`
+    Lang     string      `xml:"xml:lang,attr,omitempty"`
+    ID       string      `xml:"id"`
+    Title    string      `xml:"title"`
+    Subtitle string      `xml:"subtitle,omitempty"`
+    Updated  string      `xml:"updated"`
+    Links    []AtomLink  `xml:"link"`
+    Entries  []AtomEntry `xml:"entry"`
+}
+
+type AtomEntry struct {
+    ID         string         `xml:"id"`
+    Title      string         `xml:"title"`
+    Updated    string         `xml:"updated"`
+    Published  string         `xml:"published,omitempty"`
+    Links      []AtomLink     `xml:"link"`
+    Authors    []AtomPerson   `xml:"author"`
+    Categories []AtomCategory `xml:"category"`
+    Summary    *AtomText      `xml:"summary,omitempty"`
+    Content    *AtomText      `xml:"content,omitempty"`
+}
+
+type AtomPerson struct {
+    Name string `xml:"name"`
+    URI  string `xml:"uri,omitempty"`
+}
+
+type AtomCategory struct {
+    Term  string `xml:"term,attr"`
+    Label string `xml:"label,attr,omitempty"`
+}
+
+type AtomText struct {
+    Type  string `xml:"type,attr"`
+    Value string `xml:",chardata"`
+}
+
+func NewAtomFeed(feed AtomFeed) AtomFeed {
+    feed.XMLNS = "http://www.w3.org/2005/Atom"
+    return feed
+}
@@ -0,0 +1,40 @@
+package feeds
+
+import "encoding/json"
+
+// JSON Feed 1.1, see https://www.jsonfeed.org/version/1.1/
+
+type JSONFeed struct {
+    Version     string         `json:"version"`
+    Title       string         `json:"title"`
+    HomePageURL string         `json:"home_page_url"`
+    FeedURL     string         `json:"feed_url"`
+    Description string         `json:"description,omitempty"`
+    Language    string         `json:"language,omitempty"`
+    Items       []JSONFeedItem `json:"items"`
+}
+
+type JSONFeedItem struct {
+    ID            string           `json:"id"`
+    URL           string           `json:"url"`
+    Title         string           `json:"title"`
+    ContentHTML   string           `json:"content_html,omitempty"`
+    ContentText   string           `json:"content_text,omitempty"`
+    Summary       string           `json:"summary,omitempty"`
+    Image         string           `json:"image,omitempty"`
+    DatePublished string           `json:"date_published,omitempty"`
+    DateModified  string           `json:"date_modified,omitempty"`
+    Authors       []JSONFeedAuthor `json:"authors,omitempty"`
+    Tags          []string         `json:"tags,omitempty"`
+}
+
+type JSONFeedAuthor struct {
+    Name   string `json:"name"`
+    URL    string `json:"url,omitempty"`
+    Avatar string `json:"avatar,omitempty"`
+}
+
+func MarshalJSONFeed(feed JSONFeed) ([]byte, error) {
+    feed.Version = "https://jsonfeed.org/version/1.1"
+    return json.MarshalIndent(feed, "", "  ")
+}
@@ -0,0 +1,139 @@
+package feeds
+
+import (
+    "crypto/sha1"
+    "encoding/xml"
+    "fmt"
+    "strings"
+)
+
+// RSS 2.0 with the iTunes and Podcasting 2.0 namespaces.
+// encoding/xml has no prefix support, so prefixed names are written literally.
+
+type PodcastRSS struct {
+    XMLName      xml.Name       `xml:"rss"`
+    Version      string         `xml:"version,attr"`
+    XMLNSItunes  string         `xml:"xmlns:itunes,attr"`
+    XMLNSPodcast string         `xml:"xmlns:podcast,attr"`
+    XMLNSAtom    string         `xml:"xmlns:atom,attr"`
+    Channel      PodcastChannel `xml:"channel"`
+}
+
+type PodcastChannel struct {
+    Title          string          `xml:"title"`
+    Link           string          `xml:"link"`
+    AtomLink       AtomLink        `xml:"atom:link"`
+    Description    string          `xml:"description"`
+    Language       string          `xml:"language,omitempty"`
+    Copyright      string          `xml:"copyright,omitempty"`
+    LastBuildDate  string          `xml:"lastBuildDate,omitempty"`
+    ItunesAuthor   string          `xml:"itunes:author,omitempty"`
+    ItunesOwner    *ItunesOwner    `xml:"itunes:owner,omitempty"`
+    ItunesImage    *ItunesImage    `xml:"itunes:image,omitempty"`
+    ItunesCategory *ItunesCategory `xml:"itunes:category,omitempty"`
+    ItunesExplicit string          `xml:"itunes:explicit"`
+    ItunesType     string          `xml:"itunes:type,omitempty"`
+    PodcastLocked  *PodcastLocked  `xml:"podcast:locked,omitempty"`
+    PodcastGUID    string          `xml:"podcast:guid,omitempty"`
+    Items          []PodcastItem   `xml:"item"`
+}
+
+type AtomLink struct {
+    Href string `xml:"href,attr"`
+    Rel  string `xml:"rel,attr"`
+    Type string `xml:"type,attr"`
+}
+
+type ItunesOwner struct {
+    Name  string `xml:"itunes:name,omitempty"`
+    Email string `xml:"itunes:email,omitempty"`
+}
+
+type ItunesImage struct {
+    Href string `xml:"href,attr"`
+}
+
+type ItunesCategory struct {
+    Text        string          `xml:"text,attr"`
+    Subcategory *ItunesCategory `xml:"itunes:category,omitempty"`
+}
+
+type PodcastLocked struct {
+    Owner string `xml:"owner,attr,omitempty"`
+    Value string `xml:",chardata"`
+}
+
+type PodcastItem struct {
+    Title             string       `xml:"title"`
+    Link              string       `xml:"link"`
+    Description       string       `xml:"description"`
+    GUID              GUID         `xml:"guid"`
+    PubDate           string       `xml:"pubDate"`
+    Author            string       `xml:"author,omitempty"`
+    Enclosure         Enclosure    `xml:"enclosure"`
+    ItunesTitle       string       `xml:"itunes:title"`
+    ItunesAuthor      string       `xml:"itunes:author,omitempty"`
+    ItunesDuration    int          `xml:"itunes:duration"` // Seconds
+    ItunesImage       *ItunesImage `xml:"itunes:image,omitempty"`
+    ItunesExplicit    string       `xml:"itunes:explicit"`
+    ItunesEpisode     *int         `xml:"itunes:episode,omitempty"`
+    ItunesSeason      *int         `xml:"itunes:season,omitempty"`
+    ItunesEpisodeType string       `xml:"itunes:episodeType,omitempty"`
+    PodcastEpisode    *int         `xml:"podcast:episode,omitempty"`
+    PodcastSeason     *int         `xml:"podcast:season,omitempty"`
+}
+
+type GUID struct {
+    IsPermaLink bool   `xml:"isPermaLink,attr"`
+    Value       string `xml:",chardata"`
+}
+
+type Enclosure struct {
+    URL    string `xml:"url,attr"`
+    Length int64  `xml:"length,attr"`
+    Type   string `xml:"type,attr"`
+}
+
+func NewPodcastRSS(channel PodcastChannel) PodcastRSS {
+    return PodcastRSS{
+        Version:      "2.0",
+        XMLNSItunes:  "http://www.itunes.com/dtds/podcast-1.0.dtd",
+        XMLNSPodcast: "https://podcastindex.org/namespace/1.0",
+        XMLNSAtom:    "http://www.w3.org/2005/Atom",
+        Channel:      channel,
+    }
+}
+
+// Marshal renders any feed document with the XML declaration
+func Marshal(doc interface{}) ([]byte, error) {
+    body, err := xml.MarshalIndent(doc, "", "  ")
+    if err != nil {
+        return nil, err
+    }
+    return append([]byte(xml.Header), body...), nil
+}
+
+// ExplicitValue renders the flag the way the iTunes spec wants it
+func ExplicitValue(explicit bool) string {
+    if explicit {
+        return "true"
+    }
+    return "false"
+}
+
+// PodcastGUID derives the Podcasting 2.0 GUID: a UUIDv5 of the feed URL without its scheme
+func PodcastGUID(feedURL string) string {
+    namespace := []byte{0xea, 0xd4, 0xc2, 0x36, 0xbf, 0x58, 0x58, 0xc6, 0xa2, 0xc6, 0xa6, 0xb2, 0x8d, 0x12, 0x8c, 0xb6}
+    name := feedURL
+    if i := strings.Index(name, "://"); i >= 0 {
+        name = name[i+3:]
+    }
+    name = strings.TrimRight(name, "/")
+
+    hash := sha1.Sum(append(namespace, name...))
+    uuid := hash[:16]
+    uuid[6] = uuid[6]&0x0f | 0x50 // Version 5
+    uuid[8] = uuid[8]&0x3f | 0x80 // RFC 4122 variant
+
+    return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
+}
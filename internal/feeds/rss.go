@@ -0,0 +1,51 @@
+package feeds
+
+import "encoding/xml"
+
+// RSS 2.0 with content:encoded for full articles and dc:creator for author names
+
+type RSS struct {
+    XMLName      xml.Name   `xml:"rss"`
+    Version      string     `xml:"version,attr"`
+    XMLNSAtom    string     `xml:"xmlns:atom,attr"`
+    XMLNSContent string     `xml:"xmlns:content,attr"`
+    XMLNSDC      string     `xml:"xmlns:dc,attr"`
+    Channel      RSSChannel `xml:"channel"`
+}
+
+type RSSChannel struct {
+    Title         string    `xml:"title"`
+    Link          string    `xml:"link"`
+    AtomLink      AtomLink  `xml:"atom:link"`
+    Description   string    `xml:"description"`
+    Language      string    `xml:"language,omitempty"`
+    LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+    Items         []RSSItem `xml:"item"`
+}
+
+type RSSItem struct {
+    Title       string     `xml:"title"`
+    Link        string     `xml:"link"`
+    GUID        GUID       `xml:"guid"`
+    PubDate     string     `xml:"pubDate"`
+    Description string     `xml:"description"`
+    Content     *CDATA     `xml:"content:encoded,omitempty"`
+    Creators    []string   `xml:"dc:creator"`
+    Categories  []string   `xml:"category"`
+    Enclosure   *Enclosure `xml:"enclosure,omitempty"`
+}
+
+// CDATA keeps article HTML readable in the feed instead of entity-escaping it
+type CDATA struct {
+    Value string `xml:",cdata"`
+}
+
+func NewRSS(channel RSSChannel) RSS {
+    return RSS{
+        Version:      "2.0",
+        XMLNSAtom:    "http://www.w3.org/2005/Atom",
+        XMLNSContent: "http://purl.org/rss/1.0/modules/content/",
+        XMLNSDC:      "http://purl.org/dc/elements/1.1/",
+        Channel:      channel,
+    }
+}
@@ -0,0 +1,54 @@
+package feeds
+
+import "encoding/xml"
+
+// Sitemaps protocol 0.9, with xhtml:link alternates for language versions
+
+// Sitemaps may list at most this many URLs, beyond that an index is needed
+const SitemapMaxURLs = 50000
+
+type URLSet struct {
+    XMLName    xml.Name     `xml:"urlset"`
+    XMLNS      string       `xml:"xmlns,attr"`
+    XMLNSXHTML string       `xml:"xmlns:xhtml,attr"`
+    URLs       []SitemapURL `xml:"url"`
+}
+
+type SitemapURL struct {
+    Loc        string             `xml:"loc"`
+    LastMod    string             `xml:"lastmod,omitempty"`
+    Alternates []SitemapAlternate `xml:"xhtml:link"`
+}
+
+// SitemapAlternate is an hreflang link to the same page in another language
+type SitemapAlternate struct {
+    Rel      string `xml:"rel,attr"`
+    Hreflang string `xml:"hreflang,attr"`
+    Href     string `xml:"href,attr"`
+}
+
+type SitemapIndex struct {
+    XMLName  xml.Name       `xml:"sitemapindex"`
+    XMLNS    string         `xml:"xmlns,attr"`
+    Sitemaps []SitemapEntry `xml:"sitemap"`
+}
+
+type SitemapEntry struct {
+    Loc     string `xml:"loc"`
+    LastMod string `xml:"lastmod,omitempty"`
+}
+
+func NewURLSet(urls []SitemapURL) URLSet {
+    return URLSet{
+        XMLNS:      "http://www.sitemaps.org/schemas/sitemap/0.9",
+        XMLNSXHTML: "http://www.w3.org/1999/xhtml",
+        URLs:       urls,
+    }
+}
+
+func NewSitemapIndex(sitemaps []SitemapEntry) SitemapIndex {
+    return SitemapIndex{
+        XMLNS:    "http://www.sitemaps.org/schemas/sitemap/0.9",
+        Sitemaps: sitemaps,
+    }
+}
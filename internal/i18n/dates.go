@@ -0,0 +1,142 @@
+package i18n
+
+import (
+    "fmt"
+    "strings"
+    "time"
+)
+
+// Locales that dates can be formatted in
+var Locales = []string{"bn", "fi", "en"}
+
+var banglaMonths = [12]string{
+    "জানুয়ারি", "ফেব্রুয়ারি", "মার্চ", "এপ্রিল", "মে", "জুন",
+    "জুলাই", "আগস্ট", "সেপ্টেম্বর", "অক্টোবর", "নভেম্বর", "ডিসেম্বর",
+}
+
+// Partitive, as in "20. kesäkuuta 2025"
+var finnishMonths = [12]string{
+    "tammikuuta", "helmikuuta", "maaliskuuta", "huhtikuuta", "toukokuuta", "kesäkuuta",
+    "heinäkuuta", "elokuuta", "syyskuuta", "lokakuuta", "marraskuuta", "joulukuuta",
+}
+
+// BanglaDigits replaces ASCII digits with Bangla ones (০১২৩৪৫৬৭৮৯)
+func BanglaDigits(s string) string {
+    return strings.Map(func(r rune) rune {
+        if r >= '0' && r <= '9' {
+            return '০' + (r - '0')
+        }
+        return r
+    }, s)
+}
+
+// FormatDate renders a day, e.g. "June 20, 2025", "২০ জুন ২০২৫", "20. kesäkuuta 2025"
+func FormatDate(t time.Time, locale string) string {
+    switch locale {
+    case "bn":
+        return BanglaDigits(fmt.Sprintf("%d %s %d", t.Day(), banglaMonths[t.Month()-1], t.Year()))
+    case "fi":
+        return fmt.Sprintf("%d. %s %d", t.Day(), finnishMonths[t.Month()-1], t.Year())
+    default:
+        return t.Format("January 2, 2006")
+    }
+}
+
+// FormatDateTime renders a day and time, e.g. "June 23, 2025 at 4:30 PM",
+// "২৩ জুন ২০২৫, বিকাল ৪:৩০", "23. kesäkuuta 2025 klo 16.30"
+func FormatDateTime(t time.Time, locale string) string {
+    switch locale {
+    case "bn":
+        hour := t.Hour() % 12
+        if hour == 0 {
+            hour = 12
+        }
+        return BanglaDigits(fmt.Sprintf("%s, %s %d:%02d", FormatDate(t, locale), banglaDayPeriod(t.Hour()), hour, t.Minute()))
+    case "fi":
+        return fmt.Sprintf("%s klo %d.%02d", FormatDate(t, locale), t.Hour(), t.Minute())
+    default:
+        return t.Format("January 2, 2006 at 3:04 PM")
+    }
+}
+
+// Bangla clocks name the part of the day instead of AM/PM
+func banglaDayPeriod(hour int) string {
+    switch {
+    case hour >= 4 && hour < 6:
+        return "ভোর"
+    case hour >= 6 && hour < 12:
+        return "সকাল"
+    case hour >= 12 && hour < 15:
+        return "দুপুর"
+    case hour >= 15 && hour < 18:
+        return "বিকাল"
+    case hour >= 18 && hour < 20:
+        return "সন্ধ্যা"
+    default:
+        return "রাত"
+    }
+}
+
+type relativeUnit struct {
+    en, bn        string
+    fiOne, fiMany string
+}
+
+var (
+    unitMinute = relativeUnit{"minute", "মিনিট", "minuutti", "minuuttia"}
+    unitHour   = relativeUnit{"hour", "ঘণ্টা", "tunti", "tuntia"}
+    unitDay    = relativeUnit{"day", "দিন", "päivä", "päivää"}
+    unitMonth  = relativeUnit{"month", "মাস", "kuukausi", "kuukautta"}
+    unitYear   = relativeUnit{"year", "বছর", "vuosi", "vuotta"}
+)
+
+// RelativeTime describes how long ago t was, e.g. "3 hours ago", "৩ ঘণ্টা আগে", "3 tuntia sitten".
+// Times in the future count as just now.
+func RelativeTime(t, now time.Time, locale string) string {
+    elapsed := now.Sub(t)
+
+    switch {
+    case elapsed < time.Minute:
+        return pick(locale, "এইমাত্র", "juuri nyt", "just now")
+    case elapsed < time.Hour:
+        return ago(int(elapsed/time.Minute), unitMinute, locale)
+    case elapsed < 24*time.Hour:
+        return ago(int(elapsed/time.Hour), unitHour, locale)
+    case elapsed < 48*time.Hour:
+        return pick(locale, "গতকাল", "eilen", "yesterday")
+    case elapsed < 30*24*time.Hour:
+        return ago(int(elapsed/(24*time.Hour)), unitDay, locale)
+    case elapsed < 365*24*time.Hour:
+        return ago(int(elapsed/(30*24*time.Hour)), unitMonth, locale)
+    default:
+        return ago(int(elapsed/(365*24*time.Hour)), unitYear, locale)
+    }
+}
+
+func pick(locale, bn, fi, en string) string {
+    switch locale {
+    case "bn":
+        return bn
+    case "fi":
+        return fi
+    default:
+        return en
+    }
+}
+
+func ago(n int, unit relativeUnit, locale string) string {
+    switch locale {
+    case "bn":
+        return BanglaDigits(fmt.Sprintf("%d %s আগে", n, unit.bn))
+    case "fi":
+        if n == 1 {
+            return fmt.Sprintf("%d %s sitten", n, unit.fiOne)
+        }
+        return fmt.Sprintf("%d %s sitten", n, unit.fiMany)
+    default:
+        if n == 1 {
+            return fmt.Sprintf("%d %s ago", n, unit.en)
+        }
+        return fmt.Sprintf("%d %ss ago", n, unit.en)
+    }
+}
@@ -0,0 +1,54 @@
+package i18n
+
+import (
+    "sort"
+    "strconv"
+    "strings"
+)
+
+// Negotiate picks the best supported language for an Accept-Language header,
+// e.g. "en-GB,en;q=0.9,bn;q=0.8". Region subtags match their base language.
+// Returns "" when nothing matches.
+func Negotiate(acceptLanguage string, supported []string) string {
+    type candidate struct {
+        lang string
+        q    float64
+    }
+
+    var candidates []candidate
+    for _, part := range strings.Split(acceptLanguage, ",") {
+        fields := strings.Split(strings.TrimSpace(part), ";")
+        lang := strings.ToLower(strings.TrimSpace(fields[0]))
+        if lang == "" {
+            continue
+        }
+
+        q := 1.0
+        for _, param := range fields[1:] {
+            param = strings.TrimSpace(param)
+            if strings.HasPrefix(param, "q=") {
+                if value, err := strconv.ParseFloat(param[2:], 64); err == nil {
+                    q = value
+                }
+            }
+        }
+        if q > 0 {
+            candidates = append(candidates, candidate{lang: lang, q: q})
+        }
+    }
+
+    // Stable so equal weights keep the client's order
+    sort.SliceStable(candidates, func(i, j int) bool {
+        return candidates[i].q > candidates[j].q
+    })
+
+    for _, c := range candidates {
+        base := strings.SplitN(c.lang, "-", 2)[0]
+        for _, lang := range supported {
+            if c.lang == lang || base == lang {
+                return lang
+            }
+        }
+    }
+    return ""
+}
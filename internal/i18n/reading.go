@@ -0,0 +1,15 @@
+package i18n
+
+import "fmt"
+
+// ReadingTime labels a reading time, e.g. "5 min read", "৫ মিনিটের পড়া", "5 min lukuaika"
+func ReadingTime(minutes int, locale string) string {
+    switch locale {
+    case "bn":
+        return BanglaDigits(fmt.Sprintf("%d মিনিটের পড়া", minutes))
+    case "fi":
+        return fmt.Sprintf("%d min lukuaika", minutes)
+    default:
+        return fmt.Sprintf("%d min read", minutes)
+    }
+}
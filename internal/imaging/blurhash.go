@@ -0,0 +1,117 @@
+package imaging
+
+import (
+    "image"
+    "math"
+    "strings"
+)
+
+// Blurhash encoding, see https://github.com/woltapp/blurhash/blob/master/Algorithm.md
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// Blurhash encodes img with xComponents x yComponents frequency components (1-9 each).
+// Callers should pass a small image, the cost grows with the pixel count.
+func Blurhash(img image.Image, xComponents, yComponents int) string {
+    bounds := img.Bounds()
+    width, height := bounds.Dx(), bounds.Dy()
+
+    // Linear RGB values, converted once
+    pixels := make([][3]float64, width*height)
+    for y := 0; y < height; y++ {
+        for x := 0; x < width; x++ {
+            r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+            pixels[y*width+x] = [3]float64{
+                sRGBToLinear(int(r >> 8)),
+                sRGBToLinear(int(g >> 8)),
+                sRGBToLinear(int(b >> 8)),
+            }
+        }
+    }
+
+    factors := make([][3]float64, 0, xComponents*yComponents)
+    for j := 0; j < yComponents; j++ {
+        for i := 0; i < xComponents; i++ {
+            normalisation := 2.0
+            if i == 0 && j == 0 {
+                normalisation = 1
+            }
+
+            var factor [3]float64
+            for y := 0; y < height; y++ {
+                for x := 0; x < width; x++ {
+                    basis := normalisation *
+                        math.Cos(math.Pi*float64(i)*float64(x)/float64(width)) *
+                        math.Cos(math.Pi*float64(j)*float64(y)/float64(height))
+                    pixel := pixels[y*width+x]
+                    factor[0] += basis * pixel[0]
+                    factor[1] += basis * pixel[1]
+                    factor[2] += basis * pixel[2]
+                }
+            }
+
+            scale := 1 / float64(width*height)
+            factors = append(factors, [3]float64{factor[0] * scale, factor[1] * scale, factor[2] * scale})
+        }
+    }
+
+    var hash strings.Builder
+    hash.WriteString(encode83((xComponents-1)+(yComponents-1)*9, 1))
+
+    maximumValue := 1.0
+    ac := factors[1:]
+    if len(ac) > 0 {
+        actualMaximum := 0.0
+        for _, factor := range ac {
+            for _, value := range factor {
+                actualMaximum = math.Max(actualMaximum, math.Abs(value))
+            }
+        }
+        quantisedMaximum := int(math.Max(0, math.Min(82, math.Floor(actualMaximum*166-0.5))))
+        maximumValue = float64(quantisedMaximum+1) / 166
+        hash.WriteString(encode83(quantisedMaximum, 1))
+    } else {
+        hash.WriteString(encode83(0, 1))
+    }
+
+    dc := factors[0]
+    hash.WriteString(encode83(linearToSRGB(dc[0])<<16+linearToSRGB(dc[1])<<8+linearToSRGB(dc[2]), 4))
+
+    for _, factor := range ac {
+        quantise := func(value float64) int {
+            return int(math.Max(0, math.Min(18, math.Floor(signPow(value/maximumValue, 0.5)*9+9.5))))
+        }
+        hash.WriteString(encode83(quantise(factor[0])*19*19+quantise(factor[1])*19+quantise(factor[2]), 2))
+    }
+
+    return hash.String()
+}
+
+func encode83(value, length int) string {
+    result := make([]byte, length)
+    for i := 1; i <= length; i++ {
+        digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+        result[i-1] = base83Chars[digit]
+    }
+    return string(result)
+}
+
+func sRGBToLinear(value int) float64 {
+    v := float64(value) / 255
+    if v <= 0.04045 {
+        return v / 12.92
+    }
+    return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) int {
+    v := math.Max(0, math.Min(1, value))
+    if v <= 0.0031308 {
+        return int(v*12.92*255 + 0.5)
+    }
+    return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(value, exp float64) float64 {
+    return math.Copysign(math.Pow(math.Abs(value), exp), value)
+}
@@ -0,0 +1,253 @@
+package imaging
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "image"
+    "image/color"
+    "image/jpeg"
+    "image/png"
+    "os"
+    "os/exec"
+    "path/filepath"
+    "time"
+
+    _ "image/gif"
+
+    "golang.org/x/image/draw"
+    _ "golang.org/x/image/webp"
+)
+
+// VariantSpec is a named target width for a responsive image variant
+type VariantSpec struct {
+    Name  string
+    Width int
+}
+
+// Variants generated for every uploaded image, smallest first
+var Variants = []VariantSpec{
+    {Name: "thumbnail", Width: 320},
+    {Name: "card", Width: 640},
+    {Name: "hero", Width: 1600},
+}
+
+type Variant struct {
+    Name        string
+    Format      string // "jpeg" or "webp"
+    ContentType string
+    Width       int
+    Height      int
+    Data        []byte
+}
+
+type Result struct {
+    Original []byte // Upload with metadata removed and EXIF rotation applied
+    Width    int
+    Height   int
+    Blurhash string
+    Variants []Variant
+}
+
+const (
+    jpegQuality = 82
+    webpQuality = 80
+
+    // Largest image we decode, about 50 megapixels. Decoding needs 4 bytes a
+    // pixel, so a tiny file claiming huge dimensions can't eat the memory.
+    maxPixels = 50_000_000
+)
+
+var (
+    ErrUnsupportedImage = errors.New("unsupported or malformed image")
+    ErrImageTooLarge    = errors.New("image dimensions are too large")
+)
+
+// Processor turns an uploaded image into clean, resized variants.
+// WebP variants need the cwebp binary; without it only JPEG variants are made.
+type Processor struct {
+    webpEncoder string
+}
+
+func NewProcessor(webpEncoder string) *Processor {
+    return &Processor{webpEncoder: webpEncoder}
+}
+
+// Process fails with ErrUnsupportedImage or ErrImageTooLarge when the upload
+// itself is at fault, any other error is ours
+func (p *Processor) Process(data []byte, contentType string) (*Result, error) {
+    // Read just the header first so oversized images are refused before decoding
+    config, _, err := image.DecodeConfig(bytes.NewReader(data))
+    if err != nil {
+        return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+    }
+    if config.Width <= 0 || config.Height <= 0 {
+        return nil, ErrUnsupportedImage
+    }
+    if int64(config.Width)*int64(config.Height) > maxPixels {
+        return nil, ErrImageTooLarge
+    }
+
+    img, _, err := image.Decode(bytes.NewReader(data))
+    if err != nil {
+        return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+    }
+
+    original, err := StripMetadata(data, contentType)
+    if err != nil {
+        return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+    }
+
+    // Stripping EXIF loses the rotation, so bake it into the pixels instead
+    if contentType == "image/jpeg" {
+        if orientation := jpegOrientation(data); orientation != 1 {
+            img = applyOrientation(img, orientation)
+            if original, err = encodeJPEG(img, 92); err != nil {
+                return nil, err
+            }
+        }
+    }
+
+    bounds := img.Bounds()
+    result := &Result{
+        Original: original,
+        Width:    bounds.Dx(),
+        Height:   bounds.Dy(),
+        Blurhash: Blurhash(resize(img, 32), 4, 3),
+    }
+
+    lastWidth := 0
+    for _, spec := range Variants {
+        // Small images would produce identical larger variants, stop once we hit the original size
+        if lastWidth >= result.Width {
+            break
+        }
+        resized := resize(img, spec.Width)
+        size := resized.Bounds()
+        lastWidth = size.Dx()
+
+        jpegData, err := encodeJPEG(resized, jpegQuality)
+        if err != nil {
+            return nil, err
+        }
+        result.Variants = append(result.Variants, Variant{
+            Name:        spec.Name,
+            Format:      "jpeg",
+            ContentType: "image/jpeg",
+            Width:       size.Dx(),
+            Height:      size.Dy(),
+            Data:        jpegData,
+        })
+
+        if p.webpEncoder == "" {
+            continue
+        }
+        webpData, err := p.encodeWebP(resized)
+        if err != nil {
+            return nil, err
+        }
+        result.Variants = append(result.Variants, Variant{
+            Name:        spec.Name,
+            Format:      "webp",
+            ContentType: "image/webp",
+            Width:       size.Dx(),
+            Height:      size.Dy(),
+            Data:        webpData,
+        })
+    }
+
+    return result, nil
+}
+
+// Scale to the given width keeping the aspect ratio. Images are never upscaled.
+// Transparent areas are flattened onto white since JPEG has no alpha.
+func resize(img image.Image, width int) image.Image {
+    bounds := img.Bounds()
+    if width > bounds.Dx() {
+        width = bounds.Dx()
+    }
+    height := bounds.Dy() * width / bounds.Dx()
+    if height < 1 {
+        height = 1
+    }
+
+    dst := image.NewRGBA(image.Rect(0, 0, width, height))
+    draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+    draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+    return dst
+}
+
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+    var buf bytes.Buffer
+    if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+        return nil, fmt.Errorf("failed to encode JPEG: %v", err)
+    }
+    return buf.Bytes(), nil
+}
+
+func (p *Processor) encodeWebP(img image.Image) ([]byte, error) {
+    dir, err := os.MkdirTemp("", "webp-*")
+    if err != nil {
+        return nil, err
+    }
+    defer os.RemoveAll(dir)
+
+    input := filepath.Join(dir, "in.png")
+    output := filepath.Join(dir, "out.webp")
+
+    var buf bytes.Buffer
+    if err := png.Encode(&buf, img); err != nil {
+        return nil, err
+    }
+    if err := os.WriteFile(input, buf.Bytes(), 0600); err != nil {
+        return nil, err
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+    defer cancel()
+
+    cmd := exec.CommandContext(ctx, p.webpEncoder, "-quiet", "-metadata", "none", "-q", fmt.Sprint(webpQuality), input, "-o", output)
+    if out, err := cmd.CombinedOutput(); err != nil {
+        return nil, fmt.Errorf("failed to encode WebP: %v: %s", err, out)
+    }
+    return os.ReadFile(output)
+}
+
+// Rotate/flip according to the EXIF orientation values 2-8
+func applyOrientation(img image.Image, orientation int) image.Image {
+    bounds := img.Bounds()
+    width, height := bounds.Dx(), bounds.Dy()
+
+    dstWidth, dstHeight := width, height
+    if orientation >= 5 {
+        dstWidth, dstHeight = height, width
+    }
+    dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+    for y := 0; y < height; y++ {
+        for x := 0; x < width; x++ {
+            var dx, dy int
+            switch orientation {
+            case 2: // Mirror horizontal
+                dx, dy = width-1-x, y
+            case 3: // Rotate 180
+                dx, dy = width-1-x, height-1-y
+            case 4: // Mirror vertical
+                dx, dy = x, height-1-y
+            case 5: // Mirror horizontal and rotate 270 CW
+                dx, dy = y, x
+            case 6: // Rotate 90 CW
+                dx, dy = height-1-y, x
+            case 7: // Mirror horizontal and rotate 90 CW
+                dx, dy = height-1-y, width-1-x
+            case 8: // Rotate 270 CW
+                dx, dy = y, width-1-x
+            default:
+                dx, dy = x, y
+            }
+            dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+        }
+    }
+    return dst
+}
@@ -0,0 +1,199 @@
+package imaging
+
+import (
+    "bytes"
+    "encoding/binary"
+    "errors"
+)
+
+var errMalformed = errors.New("malformed image data")
+
+// StripMetadata removes EXIF/XMP/IPTC and text metadata (GPS location, camera serials, ...)
+// without re-encoding, so the image data is untouched.
+func StripMetadata(data []byte, contentType string) ([]byte, error) {
+    switch contentType {
+    case "image/jpeg":
+        return stripJPEG(data)
+    case "image/png":
+        return stripPNG(data)
+    case "image/webp":
+        return stripWebP(data)
+    default:
+        return data, nil // GIF has no standard place for EXIF
+    }
+}
+
+func stripJPEG(data []byte) ([]byte, error) {
+    if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+        return nil, errMalformed
+    }
+
+    out := bytes.NewBuffer(make([]byte, 0, len(data)))
+    out.Write(data[:2])
+
+    pos := 2
+    for pos+4 <= len(data) {
+        if data[pos] != 0xFF {
+            return nil, errMalformed
+        }
+        marker := data[pos+1]
+        if marker == 0xFF { // Fill byte
+            pos++
+            continue
+        }
+
+        length := int(binary.BigEndian.Uint16(data[pos+2:]))
+        end := pos + 2 + length
+        if length < 2 || end > len(data) {
+            return nil, errMalformed
+        }
+
+        // Start of scan: the rest is compressed image data
+        if marker == 0xDA {
+            out.Write(data[pos:])
+            return out.Bytes(), nil
+        }
+
+        // APP1 (EXIF/XMP), APP13 (IPTC) and comments are dropped
+        if marker != 0xE1 && marker != 0xED && marker != 0xFE {
+            out.Write(data[pos:end])
+        }
+        pos = end
+    }
+    return nil, errMalformed
+}
+
+var pngMetadataChunks = map[string]bool{
+    "eXIf": true,
+    "tEXt": true,
+    "iTXt": true,
+    "zTXt": true,
+    "tIME": true,
+}
+
+func stripPNG(data []byte) ([]byte, error) {
+    const signatureLen = 8
+    if len(data) < signatureLen {
+        return nil, errMalformed
+    }
+
+    out := bytes.NewBuffer(make([]byte, 0, len(data)))
+    out.Write(data[:signatureLen])
+
+    pos := signatureLen
+    for pos < len(data) {
+        if pos+8 > len(data) {
+            return nil, errMalformed
+        }
+        length := int(binary.BigEndian.Uint32(data[pos:]))
+        chunkType := string(data[pos+4 : pos+8])
+        end := pos + 12 + length // length + type + data + CRC
+        if length < 0 || end > len(data) {
+            return nil, errMalformed
+        }
+
+        if !pngMetadataChunks[chunkType] {
+            out.Write(data[pos:end])
+        }
+        pos = end
+    }
+    return out.Bytes(), nil
+}
+
+func stripWebP(data []byte) ([]byte, error) {
+    if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+        return nil, errMalformed
+    }
+
+    out := bytes.NewBuffer(make([]byte, 0, len(data)))
+    out.Write(data[:12])
+
+    pos := 12
+    for pos < len(data) {
+        if pos+8 > len(data) {
+            return nil, errMalformed
+        }
+        fourCC := string(data[pos : pos+4])
+        size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+        end := pos + 8 + size + size%2 // Chunks are padded to an even size
+        if size < 0 || end > len(data) {
+            return nil, errMalformed
+        }
+
+        switch fourCC {
+        case "EXIF", "XMP ":
+            // Dropped
+        case "VP8X":
+            chunk := append([]byte{}, data[pos:end]...)
+            if size > 0 {
+                chunk[8] &^= 0x08 | 0x04 // Clear the EXIF and XMP flags
+            }
+            out.Write(chunk)
+        default:
+            out.Write(data[pos:end])
+        }
+        pos = end
+    }
+
+    stripped := out.Bytes()
+    binary.LittleEndian.PutUint32(stripped[4:], uint32(len(stripped)-8))
+    return stripped, nil
+}
+
+// jpegOrientation reads the EXIF orientation tag (1-8), returning 1 when there is none
+func jpegOrientation(data []byte) int {
+    if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+        return 1
+    }
+
+    pos := 2
+    for pos+4 <= len(data) && data[pos] == 0xFF {
+        marker := data[pos+1]
+        length := int(binary.BigEndian.Uint16(data[pos+2:]))
+        end := pos + 2 + length
+        if marker == 0xDA || length < 2 || end > len(data) {
+            return 1
+        }
+        if marker == 0xE1 && length > 8 && string(data[pos+4:pos+10]) == "Exif\x00\x00" {
+            return exifOrientation(data[pos+10 : end])
+        }
+        pos = end
+    }
+    return 1
+}
+
+func exifOrientation(tiff []byte) int {
+    if len(tiff) < 8 {
+        return 1
+    }
+
+    var order binary.ByteOrder
+    switch string(tiff[:2]) {
+    case "II":
+        order = binary.LittleEndian
+    case "MM":
+        order = binary.BigEndian
+    default:
+        return 1
+    }
+
+    ifd := int(order.Uint32(tiff[4:]))
+    if ifd+2 > len(tiff) {
+        return 1
+    }
+    entries := int(order.Uint16(tiff[ifd:]))
+    for i := 0; i < entries; i++ {
+        entry := ifd + 2 + i*12
+        if entry+12 > len(tiff) {
+            return 1
+        }
+        if order.Uint16(tiff[entry:]) == 0x0112 {
+            orientation := int(order.Uint16(tiff[entry+8:]))
+            if orientation < 1 || orientation > 8 {
+                return 1
+            }
+            return orientation
+        }
+    }
+    return 1
+}
@@ -22,7 +22,7 @@ func RequireAuth() gin.HandlerFunc {
 
         tokenString := strings.Replace(authHeader, "Bearer ", "", 1)
 
-        _, err := utils.ValidateJWT(tokenString)
+        claims, err := utils.ValidateJWT(tokenString)
         if err != nil {
             c.JSON(http.StatusUnauthorized, gin.H{
                 "success": false,
@@ -32,6 +32,39 @@ func RequireAuth() gin.HandlerFunc {
             return
         }
 
+        // Make the caller available to handlers
+        c.Set("user_id", claims.UserID)
+        c.Set("user_email", claims.Email)
+        c.Set("user_name", claims.Name)
+
+        c.Next()
+    }
+}
+
+// OptionalAuth identifies the caller when a valid token is sent, but lets
+// anonymous requests through too. Handlers check CurrentUserID.
+func OptionalAuth() gin.HandlerFunc {
+    return func(c *gin.Context) {
+        authHeader := c.GetHeader("Authorization")
+        if authHeader != "" {
+            tokenString := strings.Replace(authHeader, "Bearer ", "", 1)
+            if claims, err := utils.ValidateJWT(tokenString); err == nil {
+                c.Set("user_id", claims.UserID)
+                c.Set("user_email", claims.Email)
+                c.Set("user_name", claims.Name)
+            }
+        }
+
         c.Next()
     }
+}
+
+// CurrentUserID returns the ID set by RequireAuth or OptionalAuth
+func CurrentUserID(c *gin.Context) (uint, bool) {
+    value, exists := c.Get("user_id")
+    if !exists {
+        return 0, false
+    }
+    userID, ok := value.(uint)
+    return userID, ok
 }
\ No newline at end of file
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+    "auth2_google/internal/repositories"
+    "net/http"
+
+    "github.com/gin-gonic/gin"
+)
+
+// RequireRole only lets users with one of the given roles through. Use after RequireAuth.
+// The role is read from the database so demotions apply immediately.
+func RequireRole(userRepo repositories.UserRepositoryInterface, roles ...string) gin.HandlerFunc {
+    return func(c *gin.Context) {
+        userID, ok := CurrentUserID(c)
+        if !ok {
+            c.JSON(http.StatusUnauthorized, gin.H{
+                "success": false,
+                "error":   "Authentication required",
+            })
+            c.Abort()
+            return
+        }
+
+        user, err := userRepo.GetByID(userID)
+        if err != nil {
+            c.JSON(http.StatusUnauthorized, gin.H{
+                "success": false,
+                "error":   "User not found",
+            })
+            c.Abort()
+            return
+        }
+
+        for _, role := range roles {
+            if user.Role == role {
+                c.Set("user_role", user.Role)
+                c.Next()
+                return
+            }
+        }
+
+        c.JSON(http.StatusForbidden, gin.H{
+            "success": false,
+            "error":   "You don't have permission to do this",
+        })
+        c.Abort()
+    }
+}
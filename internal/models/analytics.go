@@ -0,0 +1,76 @@
+package models
+
+import "time"
+
+// PostViewDaily counts one post's page views for one day (site timezone)
+type PostViewDaily struct {
+    ID         uint      `json:"id" gorm:"primaryKey"`
+    BlogPostID uint      `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_post_view_day"`
+    Day        time.Time `json:"day" gorm:"type:date;not null;uniqueIndex:idx_post_view_day;index"`
+    Views      int64     `json:"views" gorm:"not null;default:0"`
+    Visitors   int64     `json:"visitors" gorm:"not null;default:0"` // Distinct visitors that day
+}
+
+// SiteVisitorDaily counts distinct visitors across the whole site for one day.
+// Summing PostViewDaily.Visitors would count a reader once per post they open.
+type SiteVisitorDaily struct {
+    ID       uint      `json:"id" gorm:"primaryKey"`
+    Day      time.Time `json:"day" gorm:"type:date;not null;uniqueIndex"`
+    Visitors int64     `json:"visitors" gorm:"not null;default:0"`
+}
+
+// ReferrerDaily counts views arriving from one site for one day. Host is empty for direct visits.
+type ReferrerDaily struct {
+    ID    uint      `json:"id" gorm:"primaryKey"`
+    Day   time.Time `json:"day" gorm:"type:date;not null;uniqueIndex:idx_referrer_day;index"`
+    Host  string    `json:"host" gorm:"not null;uniqueIndex:idx_referrer_day"`
+    Views int64     `json:"views" gorm:"not null;default:0"`
+}
+
+// Request DTOs
+type RecordViewRequest struct {
+    Referrer string `json:"referrer"` // document.referrer, falls back to the Referer header
+}
+
+// Response DTOs
+type AnalyticsResponse struct {
+    From      string               `json:"from"` // YYYY-MM-DD, inclusive
+    To        string               `json:"to"`
+    Views     int64                `json:"views"`
+    Visitors  int64                `json:"visitors"` // Distinct per day across the site, summed over days
+    TopPosts  []PostStatsResponse  `json:"top_posts"`
+    Referrers []ReferrerResponse   `json:"referrers"`
+    Trend     []DailyStatsResponse `json:"trend"` // One entry per day, days without views included
+}
+
+type PostStatsResponse struct {
+    PostID   uint   `json:"post_id"`
+    Title    string `json:"title"`
+    URL      string `json:"url"`
+    Views    int64  `json:"views"`
+    Visitors int64  `json:"visitors"`
+}
+
+type ReferrerResponse struct {
+    Host  string `json:"host"` // "" for direct visits
+    Views int64  `json:"views"`
+}
+
+type DailyStatsResponse struct {
+    Day      string `json:"day"`
+    Views    int64  `json:"views"`
+    Visitors int64  `json:"visitors"`
+}
+
+// Rows read back from the daily counters
+type PostViewTotal struct {
+    BlogPostID uint
+    Views      int64
+    Visitors   int64
+}
+
+type DailyViewTotal struct {
+    Day      time.Time
+    Views    int64
+    Visitors int64
+}
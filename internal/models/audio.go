@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// PostAudio is the narrated version of a post. A post has at most one, replaced in place.
+type PostAudio struct {
+    ID           uint           `json:"id" gorm:"primaryKey"`
+    BlogPostID   uint           `json:"blog_post_id" gorm:"not null;uniqueIndex"`
+    UserID       uint           `json:"user_id" gorm:"not null;index"` // Who uploaded it
+    Key          string         `json:"key" gorm:"not null"`
+    ContentType  string         `json:"content_type" gorm:"not null"`
+    Size         int64          `json:"size" gorm:"not null"`
+    Duration     float64        `json:"duration"` // Seconds
+    Bitrate      int            `json:"bitrate"`  // kbps
+    SampleRate   int            `json:"sample_rate"`
+    Channels     int            `json:"channels"`
+    OriginalName string         `json:"original_name"`
+
+    // Podcast episode details
+    Episode     *int   `json:"episode"`
+    Season      *int   `json:"season"`
+    Explicit    bool   `json:"explicit" gorm:"not null;default:false"`
+    EpisodeType string `json:"episode_type" gorm:"not null;default:full"` // full, trailer or bonus
+
+    CreatedAt    time.Time      `json:"created_at"`
+    UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type AudioResponse struct {
+    URL          string  `json:"url"`           // Streams with HTTP range support
+    Duration     float64 `json:"duration"`      // Seconds
+    DurationText string  `json:"duration_text"` // "12:34"
+    Bitrate      int     `json:"bitrate"`
+    ContentType  string  `json:"content_type"`
+    Size         int64   `json:"size"`
+    Episode      *int    `json:"episode,omitempty"`
+    Season       *int    `json:"season,omitempty"`
+    Explicit     bool    `json:"explicit"`
+    EpisodeType  string  `json:"episode_type"`
+}
+
+type UpdateEpisodeRequest struct {
+    Episode     *int    `json:"episode"`
+    Season      *int    `json:"season"`
+    Explicit    *bool   `json:"explicit"`
+    EpisodeType *string `json:"episode_type"`
+}
@@ -5,40 +5,129 @@ import (
 	"gorm.io/gorm"
 )
 type BlogPost struct {
-    ID        uint           `json:"id" gorm:"primaryKey"`
-    Title     string         `json:"title" gorm:"not null"`
-    Excerpt   string         `json:"excerpt" gorm:"type:text;not null"` // 🔥 NEW
-    Author    string         `json:"author" gorm:"not null"`            // 🔥 NEW
-    Image     string         `json:"image"`                             // 🔥 NEW
-    CreatedAt time.Time      `json:"created_at"`
-    UpdatedAt time.Time      `json:"updated_at"`
-    DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
-
-    Comments  []Comment       `json:"comments,omitempty" gorm:"foreignKey:BlogPostID"`
+    ID          uint           `json:"id" gorm:"primaryKey"`
+    Title       string         `json:"title" gorm:"not null"`
+    Excerpt     string         `json:"excerpt" gorm:"type:text;not null"` // 🔥 NEW
+    AuthorID    *uint          `json:"author_id" gorm:"index"`            // Account that created the post (nil for legacy posts)
+    Author      string         `json:"author" gorm:"not null"`            // Display name, copied from the author's account
+    Image       string         `json:"image"`                             // 🔥 NEW
+    ImageID     *uint          `json:"image_id"`                          // Uploaded media behind Image, if any
+    Content     string         `json:"content" gorm:"type:text"`          // Full article body (HTML)
+    Published   bool           `json:"published" gorm:"not null;default:false;index"`
+    PublishedAt *time.Time     `json:"published_at"` // Set the first time the post is published
+    Language    string         `json:"language" gorm:"size:8;not null;default:'bn'"` // Language of the canonical text
+
+    PreviewVersion int `json:"-" gorm:"not null;default:0"` // Bumped to revoke every preview link
+    ReviewStatus   string `json:"review_status" gorm:"size:20;not null;default:'';index"` // Editorial workflow state, "" when not under review
+
+    // Computed from Content on every save
+    WordCount      int `json:"word_count" gorm:"not null;default:0"`
+    ReadingMinutes int `json:"reading_minutes" gorm:"not null;default:0"`
+
+    // Optional overrides for link previews on social networks
+    ShareTitle       string `json:"share_title"`
+    ShareDescription string `json:"share_description" gorm:"type:text"`
+    ShareImage       string `json:"share_image"`
+    CreatedAt   time.Time      `json:"created_at"`
+    UpdatedAt   time.Time      `json:"updated_at"`
+    DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+
+    Comments   []Comment    `json:"comments,omitempty" gorm:"foreignKey:BlogPostID"`
+    Authors    []PostAuthor `json:"authors,omitempty" gorm:"foreignKey:BlogPostID"`
+    ImageMedia *Media       `json:"-" gorm:"foreignKey:ImageID"`
+    Audio      *PostAudio   `json:"audio,omitempty" gorm:"foreignKey:BlogPostID"`
+    Tags       []Tag        `json:"tags,omitempty" gorm:"many2many:post_tags"`
+
+    Translations []PostTranslation `json:"translations,omitempty" gorm:"foreignKey:BlogPostID"`
+}
+
+// PostAuthor links a post to one of its authors. Position 0 is the primary author.
+type PostAuthor struct {
+    ID         uint `json:"id" gorm:"primaryKey"`
+    BlogPostID uint `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_post_author"`
+    UserID     uint `json:"user_id" gorm:"not null;uniqueIndex:idx_post_author"`
+    Position   int  `json:"position" gorm:"not null;default:0"`
+
+    User User `json:"-" gorm:"foreignKey:UserID"`
 }
 
 // Request DTOs
+// The author is always the authenticated caller, never taken from the body
 type CreateBlogPostRequest struct {
-    Title   string `json:"title" binding:"required"`
-    Excerpt string `json:"excerpt" binding:"required"` // 🔥 NEW
-    Author  string `json:"author" binding:"required"`  // 🔥 NEW
-    Image   string `json:"image"`                       // 🔥 NEW
+    Title       string   `json:"title" binding:"required"`
+    Excerpt     string   `json:"excerpt" binding:"required"` // 🔥 NEW
+    Image       string   `json:"image"`                       // 🔥 NEW
+    ImageID     *uint    `json:"image_id"`                    // Uploaded media, overrides Image
+    CoAuthorIDs []uint   `json:"co_author_ids"`               // User IDs, in display order
+    Content     string   `json:"content"`
+    Published   bool     `json:"published"`
+    Tags        []string `json:"tags"`     // Tag names, created on first use
+    Language    string   `json:"language"` // Defaults to the site language
+
+    ShareTitle       string `json:"share_title"`
+    ShareDescription string `json:"share_description"`
+    ShareImage       string `json:"share_image"`
 }
 
 type UpdateBlogPostRequest struct {
-    Title   *string `json:"title"`
-    Excerpt *string `json:"excerpt"` // 🔥 NEW
-    Author  *string `json:"author"`  // 🔥 NEW
-    Image   *string `json:"image"`   // 🔥 NEW
+    Title       *string   `json:"title"`
+    Excerpt     *string   `json:"excerpt"`       // 🔥 NEW
+    Image       *string   `json:"image"`         // 🔥 NEW
+    ImageID     *uint     `json:"image_id"`      // Uploaded media, overrides Image
+    CoAuthorIDs *[]uint   `json:"co_author_ids"` // Replaces the co-author list when set
+    Content     *string   `json:"content"`
+    Published   *bool     `json:"published"`
+    Tags        *[]string `json:"tags"` // Replaces the tag list when set
+    Language    *string   `json:"language"`
+
+    ShareTitle       *string `json:"share_title"`
+    ShareDescription *string `json:"share_description"`
+    ShareImage       *string `json:"share_image"`
+}
+
+// ReadOptions carries the reader's preferences into post responses
+type ReadOptions struct {
+    Language string // Preferred content language, "" keeps each post's own
+    Locale   string // Locale for formatted dates: en, bn or fi
+    UserID   *uint  // Signed-in reader, nil for anonymous requests
 }
 
 //Response Data Transfer Model 
 
 type BlogPostResponse struct {
-    ID      string `json:"id"`      // 🔥 String for frontend
-    Title   string `json:"title"`
-    Excerpt string `json:"excerpt"` // 🔥 NEW
-    Author  string `json:"author"`  // 🔥 NEW
-    Date    string `json:"date"`    // 🔥 NEW - Formatted date
-    Image   string `json:"image"`   // 🔥 NEW
+    ID        string `json:"id"`      // 🔥 String for frontend
+    Title     string `json:"title"`
+    Excerpt   string `json:"excerpt"` // 🔥 NEW
+    Author    string `json:"author"`  // 🔥 NEW
+    Date      string `json:"date"`    // 🔥 NEW - Formatted date
+    Image     string `json:"image"`   // 🔥 NEW
+    Content   string `json:"content,omitempty"` // Only in single post responses
+    Published bool   `json:"published"`
+    Language  string `json:"language"` // Language of the text in this response
+
+    WordCount       int    `json:"word_count"`
+    ReadingMinutes  int    `json:"reading_minutes"`
+    ReadingTimeText string `json:"reading_time_text"` // e.g. "5 min read", in the same locale as LocalizedDate
+
+    LocalizedDate string `json:"localized_date"` // Date in the reader's locale and the site timezone, "২০ জুন ২০২৫"
+    RelativeDate  string `json:"relative_date"`  // e.g. "3 hours ago", in the same locale as LocalizedDate
+    CreatedAt     string `json:"created_at"`     // ISO-8601, in the site timezone
+    UpdatedAt     string `json:"updated_at"`
+    PublishedAt   string `json:"published_at,omitempty"`
+
+    Languages    []string          `json:"languages"`               // Every language the post can be read in, canonical first
+    Tags         []TagResponse     `json:"tags"`
+    Authors      []AuthorResponse  `json:"authors"`                 // Primary author first
+    ImageDetails *ImageResponse    `json:"image_details,omitempty"` // Only for uploaded images
+    Audio        *AudioResponse    `json:"audio,omitempty"`         // Narrated version, if any
+    Reactions    map[string]int64  `json:"reactions"`               // Count per configured reaction
+    Saved        *bool             `json:"saved,omitempty"`         // Whether the signed-in reader bookmarked it
+    Series       *SeriesNavigation `json:"series,omitempty"`        // Only in single post responses
+}
+
+type AuthorResponse struct {
+    ID         uint   `json:"id"`
+    Name       string `json:"name"`
+    Avatar     string `json:"avatar"`
+    ProfileURL string `json:"profile_url"`
 }
\ No newline at end of file
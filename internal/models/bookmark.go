@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// Bookmark is a post a user saved to read later
+type Bookmark struct {
+    ID         uint      `json:"id" gorm:"primaryKey"`
+    UserID     uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_bookmark"`
+    BlogPostID uint      `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_user_bookmark"`
+    CreatedAt  time.Time `json:"created_at"`
+}
+
+// ReadingList is a named, ordered collection of a user's saved posts
+type ReadingList struct {
+    ID        uint      `json:"id" gorm:"primaryKey"`
+    UserID    uint      `json:"user_id" gorm:"not null;index"`
+    Name      string    `json:"name" gorm:"not null"`
+    CreatedAt time.Time `json:"created_at"`
+    UpdatedAt time.Time `json:"updated_at"`
+
+    Items []ReadingListItem `json:"items,omitempty" gorm:"foreignKey:ReadingListID"`
+}
+
+type ReadingListItem struct {
+    ID            uint      `json:"id" gorm:"primaryKey"`
+    ReadingListID uint      `json:"reading_list_id" gorm:"not null;uniqueIndex:idx_list_post"`
+    BlogPostID    uint      `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_list_post;index"`
+    Position      int       `json:"position" gorm:"not null;default:0"`
+    CreatedAt     time.Time `json:"created_at"`
+}
+
+// Request DTOs
+type SaveReadingListRequest struct {
+    Name string `json:"name" binding:"required"`
+}
+
+type ReorderReadingListRequest struct {
+    PostIDs []uint `json:"post_ids" binding:"required"` // Every post in the list, in the new order
+}
+
+// Response DTOs
+type ReadingListResponse struct {
+    ID        uint               `json:"id"`
+    Name      string             `json:"name"`
+    PostCount int                `json:"post_count"`
+    CreatedAt string             `json:"created_at"`
+    UpdatedAt string             `json:"updated_at"`
+    Posts     []BlogPostResponse `json:"posts,omitempty"` // Only when a single list is requested
+}
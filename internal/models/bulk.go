@@ -0,0 +1,32 @@
+package models
+
+// Bulk post actions
+const (
+    BulkPublish      = "publish"
+    BulkUnpublish    = "unpublish"
+    BulkTag          = "tag"           // Add Tags, keeping the existing ones
+    BulkRetag        = "retag"         // Replace the tags with Tags
+    BulkDelete       = "delete"        // Into the trash, Comments says what happens to comments
+    BulkChangeAuthor = "change_author" // Make AuthorID the primary author
+)
+
+type BulkPostRequest struct {
+    IDs      []uint   `json:"ids" binding:"required"`
+    Action   string   `json:"action" binding:"required"`
+    Tags     []string `json:"tags"`      // tag and retag
+    AuthorID *uint    `json:"author_id"` // change_author
+    Comments string   `json:"comments"`  // delete: "delete" (default) or "archive"
+}
+
+// BulkPostResponse reports every post. Either all were changed or none were.
+type BulkPostResponse struct {
+    Action  string           `json:"action"`
+    Applied bool             `json:"applied"`
+    Results []BulkItemResult `json:"results"`
+}
+
+type BulkItemResult struct {
+    ID      uint   `json:"id"`
+    Success bool   `json:"success"`
+    Error   string `json:"error,omitempty"`
+}
@@ -15,6 +15,7 @@ type Comment struct {
     CreatedAt  time.Time      `json:"created_at"`
     UpdatedAt  time.Time      `json:"updated_at"`
     DeletedAt  gorm.DeletedAt `json:"-" gorm:"index"`
+    ArchivedAt *time.Time     `json:"-" gorm:"index"` // Set when the post was deleted with its comments archived
 
     // Relationships
     BlogPost BlogPost  `json:"-" gorm:"foreignKey:BlogPostID"`
@@ -22,6 +23,26 @@ type Comment struct {
     Replies  []Comment `json:"replies,omitempty" gorm:"foreignKey:ParentID"`
 }
 
+// ArchivedComment keeps an archived comment once its post is purged. It has no
+// foreign key to blog_posts, so the post row can go.
+type ArchivedComment struct {
+    ID         uint      `json:"id" gorm:"primaryKey"` // The comment's original ID
+    BlogPostID uint      `json:"blog_post_id" gorm:"index"`
+    PostTitle  string    `json:"post_title"`
+    Name       string    `json:"name"`
+    Email      string    `json:"email"`
+    Text       string    `json:"text" gorm:"type:text"`
+    ParentID   *uint     `json:"parent_id"`
+    CreatedAt  time.Time `json:"created_at"`
+    ArchivedAt time.Time `json:"archived_at"`
+}
+
+// What happens to a post's comments when the post is deleted
+const (
+    CommentsDelete  = "delete"  // Into the trash with the post, restored with it
+    CommentsArchive = "archive" // Hidden but kept, even if the post is purged
+)
+
 type CreateCommentRequest struct {
     BlogPostID uint   `json:"blog_post_id"`
     Name       string `json:"name" binding:"required"`
@@ -35,12 +56,15 @@ type UpdateCommentRequest struct {
 }
 
 type CommentResponse struct {
-    ID         uint              `json:"id"`
-    BlogPostID uint              `json:"blog_post_id"`
-    Name       string            `json:"name"`
-    Email      string            `json:"email,omitempty"`
-    Text       string            `json:"text"`
-    ParentID   *uint             `json:"parent_id"`
-    CreatedAt  string            `json:"created_at"` // Formatted date
-    Replies    []CommentResponse `json:"replies,omitempty"`
+    ID           uint              `json:"id"`
+    BlogPostID   uint              `json:"blog_post_id"`
+    Name         string            `json:"name"`
+    Email        string            `json:"email,omitempty"`
+    Text         string            `json:"text"`
+    ParentID     *uint             `json:"parent_id"`
+    CreatedAt    string            `json:"created_at"`     // "June 23, 2025 at 4:30 PM", kept for existing clients
+    CreatedAtISO string            `json:"created_at_iso"` // ISO-8601, in the site timezone
+    Date         string            `json:"date"`           // Formatted date and time in the reader's locale
+    RelativeDate string            `json:"relative_date"`  // e.g. "3 hours ago"
+    Replies      []CommentResponse `json:"replies,omitempty"`
 }
\ No newline at end of file
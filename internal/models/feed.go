@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// FeedFilter narrows a syndication feed to one tag or one author
+type FeedFilter struct {
+    TagSlug  string
+    AuthorID uint
+}
+
+// Syndication formats
+const (
+    FeedRSS  = "rss"
+    FeedAtom = "atom"
+    FeedJSON = "json"
+)
+
+// SitemapPost is the little we need per post to list it in the sitemap
+type SitemapPost struct {
+    ID        uint
+    Language  string
+    UpdatedAt time.Time
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// FeaturedPost marks a post for the homepage. Lower positions come first.
+type FeaturedPost struct {
+    ID         uint       `json:"id" gorm:"primaryKey"`
+    BlogPostID uint       `json:"blog_post_id" gorm:"not null;uniqueIndex"`
+    Position   int        `json:"position" gorm:"not null;default:0"`
+    ExpiresAt  *time.Time `json:"expires_at" gorm:"index"` // nil keeps it featured until removed
+    CreatedAt  time.Time  `json:"created_at"`
+    UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// HomepageSlot is a named section of the homepage, e.g. "hero" or "editors-picks"
+type HomepageSlot struct {
+    ID        uint      `json:"id" gorm:"primaryKey"`
+    Name      string    `json:"name" gorm:"not null;uniqueIndex"`
+    Title     string    `json:"title"`
+    Position  int       `json:"position" gorm:"not null;default:0"` // Order on the page
+    Source    string    `json:"source" gorm:"size:16;not null;default:'manual'"`
+    TagSlug   string    `json:"tag_slug"` // Only for the tag source
+    MaxPosts  int       `json:"max_posts" gorm:"not null;default:0"` // 0 uses the default
+    CreatedAt time.Time `json:"created_at"`
+    UpdatedAt time.Time `json:"updated_at"`
+
+    Posts []HomepageSlotPost `json:"posts,omitempty" gorm:"foreignKey:HomepageSlotID"`
+}
+
+// HomepageSlotPost is a hand-picked post in a manual slot
+type HomepageSlotPost struct {
+    ID             uint `json:"id" gorm:"primaryKey"`
+    HomepageSlotID uint `json:"homepage_slot_id" gorm:"not null;uniqueIndex:idx_slot_post"`
+    BlogPostID     uint `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_slot_post;index"`
+    Position       int  `json:"position" gorm:"not null;default:0"`
+}
+
+// Where a homepage slot gets its posts
+const (
+    SlotSourceManual   = "manual"   // Hand-picked posts, in order
+    SlotSourceFeatured = "featured" // Currently featured posts
+    SlotSourceLatest   = "latest"   // Newest published posts
+    SlotSourceTag      = "tag"      // Newest published posts with TagSlug
+)
+
+// Request DTOs
+type FeaturePostRequest struct {
+    Position  int        `json:"position"`
+    ExpiresAt *time.Time `json:"expires_at"` // RFC 3339, optional
+}
+
+type SaveHomepageSlotRequest struct {
+    Title    string `json:"title"`
+    Position int    `json:"position"`
+    Source   string `json:"source"` // Defaults to manual
+    TagSlug  string `json:"tag_slug"`
+    MaxPosts int    `json:"max_posts"` // 0 uses the default
+    PostIDs  []uint `json:"post_ids"`  // Manual slots only, in display order
+}
+
+// Response DTOs
+type FeaturedPostResponse struct {
+    PostID    uint   `json:"post_id"`
+    Position  int    `json:"position"`
+    ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+type HomepageSlotResponse struct {
+    Name     string             `json:"name"`
+    Title    string             `json:"title"`
+    Position int                `json:"position"`
+    Source   string             `json:"source"`
+    TagSlug  string             `json:"tag_slug,omitempty"`
+    MaxPosts int                `json:"max_posts"`
+    PostIDs  []uint             `json:"post_ids,omitempty"` // Only in the editor view
+    Posts    []BlogPostResponse `json:"posts,omitempty"`    // Only on the public homepage
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// What an ImportRecord points at
+const (
+    ImportPost    = "post"
+    ImportComment = "comment"
+    ImportImage   = "image"
+)
+
+// ImportRecord remembers what an imported item became, so running the same
+// import again skips it instead of creating a duplicate
+type ImportRecord struct {
+    ID         uint      `json:"id" gorm:"primaryKey"`
+    Source     string    `json:"source" gorm:"not null;uniqueIndex:idx_import_item"`          // e.g. the WordPress site URL
+    Kind       string    `json:"kind" gorm:"size:20;not null;uniqueIndex:idx_import_item"`
+    ExternalID string    `json:"external_id" gorm:"not null;uniqueIndex:idx_import_item"`     // ID or URL on the old site
+    LocalID    uint      `json:"local_id" gorm:"not null"`
+    CreatedAt  time.Time `json:"created_at"`
+}
+
+// Response DTOs
+type ImportCounts struct {
+    Created int `json:"created"`
+    Skipped int `json:"skipped"` // Already imported or not importable
+    Failed  int `json:"failed"`
+}
+
+type ImportReport struct {
+    Source   string       `json:"source"`
+    Posts    ImportCounts `json:"posts"`
+    Comments ImportCounts `json:"comments"`
+    Images   ImportCounts `json:"images"`
+    Tags     int          `json:"tags"` // Distinct tags and categories used by imported posts
+    Warnings []string     `json:"warnings"`
+}
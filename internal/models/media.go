@@ -0,0 +1,68 @@
+package models
+
+import (
+    "time"
+    "gorm.io/gorm"
+)
+
+// Media is a file uploaded by a user. Files are stored under a content-hashed key.
+type Media struct {
+    ID           uint           `json:"id" gorm:"primaryKey"`
+    UserID       uint           `json:"user_id" gorm:"not null;uniqueIndex:idx_media_user_hash"`
+    Hash         string         `json:"hash" gorm:"not null;uniqueIndex:idx_media_user_hash"` // SHA-256 of the content
+    Key          string         `json:"key" gorm:"not null;index"`
+    URL          string         `json:"url" gorm:"not null"`
+    ContentType  string         `json:"content_type" gorm:"not null"`
+    Size         int64          `json:"size" gorm:"not null"`
+    OriginalName string         `json:"original_name"`
+    Width        int            `json:"width"`
+    Height       int            `json:"height"`
+    Blurhash     string         `json:"blurhash"` // Placeholder shown while the image loads
+    CreatedAt    time.Time      `json:"created_at"`
+    UpdatedAt    time.Time      `json:"updated_at"`
+    DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
+
+    User     User           `json:"-" gorm:"foreignKey:UserID"`
+    Variants []MediaVariant `json:"variants,omitempty" gorm:"foreignKey:MediaID"`
+}
+
+// MediaVariant is a resized copy of an image (thumbnail, card, hero) in one format
+type MediaVariant struct {
+    ID      uint   `json:"id" gorm:"primaryKey"`
+    MediaID uint   `json:"media_id" gorm:"not null;index"`
+    Name    string `json:"name" gorm:"not null"`
+    Format  string `json:"format" gorm:"not null"` // jpeg or webp
+    Key     string `json:"key" gorm:"not null"`
+    URL     string `json:"url" gorm:"not null"`
+    Width   int    `json:"width"`
+    Height  int    `json:"height"`
+    Size    int64  `json:"size"`
+}
+
+type MediaResponse struct {
+    ID           uint   `json:"id"`
+    URL          string `json:"url"`
+    ContentType  string `json:"content_type"`
+    Size         int64  `json:"size"`
+    OriginalName string `json:"original_name"`
+    CreatedAt    string `json:"created_at"` // ISO-8601
+
+    *ImageResponse
+}
+
+// ImageResponse describes a processed image, ready for <img srcset> and <picture>
+type ImageResponse struct {
+    Width    int                    `json:"width"`
+    Height   int                    `json:"height"`
+    Blurhash string                 `json:"blurhash"`
+    Srcset   map[string]string      `json:"srcset"` // Format -> "url 320w, url 640w, ..."
+    Variants []ImageVariantResponse `json:"variants"`
+}
+
+type ImageVariantResponse struct {
+    Name   string `json:"name"`
+    Format string `json:"format"`
+    URL    string `json:"url"`
+    Width  int    `json:"width"`
+    Height int    `json:"height"`
+}
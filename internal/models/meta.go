@@ -0,0 +1,47 @@
+package models
+
+// PostMetaResponse is everything a page needs in its <head> so shared links
+// preview properly: Open Graph, Twitter Card and schema.org JSON-LD
+type PostMetaResponse struct {
+    Title       string      `json:"title"`
+    Description string      `json:"description"`
+    URL         string      `json:"url"` // Canonical address on the frontend
+    Image       string      `json:"image,omitempty"`
+    Language    string      `json:"language"`
+    OpenGraph   []MetaTag   `json:"open_graph"` // <meta property="..." content="...">
+    Twitter     []MetaTag   `json:"twitter"`    // <meta name="..." content="...">
+    JSONLD      BlogPosting `json:"json_ld"`    // <script type="application/ld+json">
+}
+
+type MetaTag struct {
+    Key     string `json:"key"`
+    Content string `json:"content"`
+}
+
+// BlogPosting follows https://schema.org/BlogPosting
+type BlogPosting struct {
+    Context          string          `json:"@context"`
+    Type             string          `json:"@type"`
+    Headline         string          `json:"headline"`
+    Description      string          `json:"description,omitempty"`
+    Image            []string        `json:"image,omitempty"`
+    DatePublished    string          `json:"datePublished,omitempty"`
+    DateModified     string          `json:"dateModified"`
+    InLanguage       string          `json:"inLanguage,omitempty"`
+    Keywords         []string        `json:"keywords,omitempty"`
+    MainEntityOfPage string          `json:"mainEntityOfPage"`
+    Author           []SchemaPerson  `json:"author,omitempty"`
+    Publisher        SchemaPublisher `json:"publisher"`
+}
+
+type SchemaPerson struct {
+    Type string `json:"@type"`
+    Name string `json:"name"`
+    URL  string `json:"url,omitempty"`
+}
+
+type SchemaPublisher struct {
+    Type string `json:"@type"`
+    Name string `json:"name"`
+    URL  string `json:"url"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// PodcastSettings holds the channel-level podcast details. There is a single row.
+type PodcastSettings struct {
+    ID          uint      `json:"-" gorm:"primaryKey"`
+    Title       string    `json:"title"`
+    Description string    `json:"description" gorm:"type:text"`
+    Author      string    `json:"author"`
+    OwnerName   string    `json:"owner_name"`
+    OwnerEmail  string    `json:"owner_email"`
+    ImageURL    string    `json:"image_url"` // Channel artwork, 1400-3000px square
+    Language    string    `json:"language"`
+    Category    string    `json:"category"`    // Apple Podcasts category, e.g. "News"
+    Subcategory string    `json:"subcategory"` // e.g. "Politics"
+    Explicit    bool      `json:"explicit"`
+    Type        string    `json:"type"` // episodic or serial
+    Copyright   string    `json:"copyright"`
+    Locked      bool      `json:"locked"` // podcast:locked, blocks imports into other hosting platforms
+    GUID        string    `json:"guid"`   // podcast:guid, generated from the feed URL when empty
+    UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type UpdatePodcastSettingsRequest struct {
+    Title       *string `json:"title"`
+    Description *string `json:"description"`
+    Author      *string `json:"author"`
+    OwnerName   *string `json:"owner_name"`
+    OwnerEmail  *string `json:"owner_email"`
+    ImageURL    *string `json:"image_url"`
+    Language    *string `json:"language"`
+    Category    *string `json:"category"`
+    Subcategory *string `json:"subcategory"`
+    Explicit    *bool   `json:"explicit"`
+    Type        *string `json:"type"`
+    Copyright   *string `json:"copyright"`
+    Locked      *bool   `json:"locked"`
+    GUID        *string `json:"guid"`
+}
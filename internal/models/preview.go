@@ -0,0 +1,13 @@
+package models
+
+// Request DTOs
+type CreatePreviewLinkRequest struct {
+    ExpiresInHours int `json:"expires_in_hours"` // 0 uses the site default
+}
+
+// Response DTOs
+type PreviewLinkResponse struct {
+    Token     string `json:"token"`
+    URL       string `json:"url"` // Frontend page that shows the draft
+    ExpiresAt string `json:"expires_at"`
+}
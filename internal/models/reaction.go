@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// PostReaction is one reader's reaction to a post. Each reader has at most one
+// per post, reacting again with another type replaces it.
+type PostReaction struct {
+    ID         uint      `json:"id" gorm:"primaryKey"`
+    BlogPostID uint      `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_post_reader"`
+    Reader     string    `json:"-" gorm:"size:80;not null;uniqueIndex:idx_post_reader"` // "user:<id>" or "anon:<hashed fingerprint>"
+    UserID     *uint     `json:"user_id" gorm:"index"`
+    Reaction   string    `json:"reaction" gorm:"size:32;not null"`
+    CreatedAt  time.Time `json:"created_at"`
+    UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Response DTOs
+type ReactionsResponse struct {
+    Counts    map[string]int64 `json:"counts"`    // Every configured reaction, zero included
+    Available []string         `json:"available"` // Display order
+    Mine      string           `json:"mine"`      // The caller's reaction, "" when none
+}
+
+// Rows read back when counting
+type ReactionCount struct {
+    BlogPostID uint
+    Reaction   string
+    Count      int64
+}
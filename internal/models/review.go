@@ -0,0 +1,98 @@
+package models
+
+import "time"
+
+// Editorial review states a post moves through before it can be published.
+// Posts written by editors skip the workflow and keep an empty status.
+const (
+    ReviewDraft            = "draft"
+    ReviewSubmitted        = "submitted"
+    ReviewInReview         = "in_review"
+    ReviewChangesRequested = "changes_requested"
+    ReviewApproved         = "approved"
+)
+
+// PostReviewer assigns an editor to review a post
+type PostReviewer struct {
+    ID           uint      `json:"id" gorm:"primaryKey"`
+    BlogPostID   uint      `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_post_reviewer"`
+    UserID       uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_post_reviewer;index"`
+    AssignedByID uint      `json:"assigned_by_id" gorm:"not null"`
+    CreatedAt    time.Time `json:"created_at"`
+
+    User User `json:"-" gorm:"foreignKey:UserID"`
+}
+
+// ReviewNote is feedback on a post under review, visible only to its
+// authors and editors. Anchored notes point at a range of the content.
+type ReviewNote struct {
+    ID          uint       `json:"id" gorm:"primaryKey"`
+    BlogPostID  uint       `json:"blog_post_id" gorm:"not null;index"`
+    AuthorID    uint       `json:"author_id" gorm:"not null"`
+    Body        string     `json:"body" gorm:"type:text;not null"`
+    AnchorStart *int       `json:"anchor_start"`          // Character offsets into the content, nil for general notes
+    AnchorEnd   *int       `json:"anchor_end"`
+    Quote       string     `json:"quote" gorm:"type:text"` // Anchored text when the note was written
+    Resolved    bool       `json:"resolved" gorm:"not null;default:false"`
+    ResolvedAt  *time.Time `json:"resolved_at"`
+    CreatedAt   time.Time  `json:"created_at"`
+    UpdatedAt   time.Time  `json:"updated_at"`
+
+    Author User `json:"-" gorm:"foreignKey:AuthorID"`
+}
+
+// Request DTOs
+type ReviewTransitionRequest struct {
+    Status string `json:"status" binding:"required"`
+    Note   string `json:"note"` // Optional message, saved as a general review note
+}
+
+type AssignReviewersRequest struct {
+    UserIDs []uint `json:"user_ids" binding:"required"` // Replaces the current reviewers
+}
+
+type CreateReviewNoteRequest struct {
+    Body        string `json:"body" binding:"required"`
+    AnchorStart *int   `json:"anchor_start"` // Set both or neither
+    AnchorEnd   *int   `json:"anchor_end"`
+}
+
+type ResolveReviewNoteRequest struct {
+    Resolved bool `json:"resolved"`
+}
+
+// Response DTOs
+type ReviewAnchor struct {
+    Start int    `json:"start"`
+    End   int    `json:"end"`
+    Quote string `json:"quote"`
+}
+
+type ReviewNoteResponse struct {
+    ID         uint           `json:"id"`
+    Body       string         `json:"body"`
+    Anchor     *ReviewAnchor  `json:"anchor,omitempty"`
+    Author     AuthorResponse `json:"author"`
+    Resolved   bool           `json:"resolved"`
+    ResolvedAt string         `json:"resolved_at,omitempty"`
+    CreatedAt  string         `json:"created_at"`
+}
+
+type ReviewResponse struct {
+    PostID    uint                 `json:"post_id"`
+    Title     string               `json:"title"`
+    Status    string               `json:"status"`
+    Published bool                 `json:"published"`
+    Reviewers []AuthorResponse     `json:"reviewers"`
+    Notes     []ReviewNoteResponse `json:"notes"`
+}
+
+type ReviewQueueItem struct {
+    PostID    uint             `json:"post_id"`
+    Title     string           `json:"title"`
+    Author    string           `json:"author"`
+    Status    string           `json:"status"`
+    UpdatedAt string           `json:"updated_at"`
+    Reviewers []AuthorResponse `json:"reviewers"`
+    OpenNotes int              `json:"open_notes"`
+}
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+// Series groups multi-part articles, e.g. "Moving to Finland: part 1–5"
+type Series struct {
+    ID          uint      `json:"id" gorm:"primaryKey"`
+    Title       string    `json:"title" gorm:"not null"`
+    Slug        string    `json:"slug" gorm:"not null;uniqueIndex"`
+    Description string    `json:"description" gorm:"type:text"`
+    CreatedAt   time.Time `json:"created_at"`
+    UpdatedAt   time.Time `json:"updated_at"`
+
+    Posts []SeriesPost `json:"posts,omitempty" gorm:"foreignKey:SeriesID"`
+}
+
+// SeriesPost places a post in a series. A post belongs to at most one series.
+type SeriesPost struct {
+    ID         uint `json:"id" gorm:"primaryKey"`
+    SeriesID   uint `json:"series_id" gorm:"not null;index"`
+    BlogPostID uint `json:"blog_post_id" gorm:"not null;uniqueIndex"`
+    Position   int  `json:"position" gorm:"not null;default:0"` // 0 is part 1
+
+    BlogPost BlogPost `json:"-" gorm:"foreignKey:BlogPostID"`
+}
+
+// Request DTOs
+type SaveSeriesRequest struct {
+    Title       string `json:"title" binding:"required"`
+    Slug        string `json:"slug"` // Derived from the title when empty
+    Description string `json:"description"`
+}
+
+type SetSeriesPostsRequest struct {
+    PostIDs []uint `json:"post_ids"` // Replaces the series' posts, part 1 first
+}
+
+// Response DTOs
+type SeriesResponse struct {
+    ID          uint               `json:"id"`
+    Title       string             `json:"title"`
+    Slug        string             `json:"slug"`
+    Description string             `json:"description"`
+    URL         string             `json:"url"`
+    PostCount   int                `json:"post_count"` // Published parts
+    CreatedAt   string             `json:"created_at"`
+    UpdatedAt   string             `json:"updated_at"`
+    Posts       []BlogPostResponse `json:"posts,omitempty"` // Only on the series landing page
+}
+
+// SeriesNavigation places a single post within its series
+type SeriesNavigation struct {
+    ID       uint        `json:"id"`
+    Title    string      `json:"title"`
+    Slug     string      `json:"slug"`
+    URL      string      `json:"url"`
+    Part     int         `json:"part"`  // 1-based
+    Total    int         `json:"total"` // Published parts
+    Previous *SeriesLink `json:"previous"`
+    Next     *SeriesLink `json:"next"`
+}
+
+type SeriesLink struct {
+    ID    string `json:"id"` // String like BlogPostResponse.ID
+    Title string `json:"title"`
+    Part  int    `json:"part"`
+    URL   string `json:"url"`
+}
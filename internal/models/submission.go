@@ -0,0 +1,109 @@
+package models
+
+import "time"
+
+// Where a guest's pitch stands
+const (
+    SubmissionPending   = "pending"
+    SubmissionReviewing = "reviewing"
+    SubmissionAccepted  = "accepted"
+    SubmissionRejected  = "rejected"
+)
+
+// Submission is an article pitched by someone without an account
+type Submission struct {
+    ID          uint      `json:"id" gorm:"primaryKey"`
+    Title       string    `json:"title" gorm:"not null"`
+    Excerpt     string    `json:"excerpt" gorm:"type:text"`
+    Content     string    `json:"content" gorm:"type:text;not null"`     // Plain text as the guest wrote it
+    Name        string    `json:"name" gorm:"not null"`
+    Email       string    `json:"email" gorm:"not null"`
+    Bio         string    `json:"bio" gorm:"type:text"`
+    Language    string    `json:"language" gorm:"size:8;not null"`
+    Status      string    `json:"status" gorm:"size:20;not null;default:'pending';index"`
+    Message     string    `json:"message" gorm:"type:text"`              // Shown to the guest on the status page
+    TokenHash   string    `json:"-" gorm:"size:64;uniqueIndex;not null"` // SHA-256 of the secret status link token
+    VisitorHash string    `json:"-" gorm:"size:32;index"`                // Keyed hash of the IP, only used for rate limiting
+    BlogPostID  *uint     `json:"blog_post_id"`                          // Draft created on acceptance
+    ReviewedBy  *uint     `json:"reviewed_by"`
+    CreatedAt   time.Time `json:"created_at"`
+    UpdatedAt   time.Time `json:"updated_at"`
+
+    Attachments []SubmissionAttachment `json:"attachments,omitempty" gorm:"foreignKey:SubmissionID"`
+}
+
+type SubmissionAttachment struct {
+    ID           uint      `json:"id" gorm:"primaryKey"`
+    SubmissionID uint      `json:"submission_id" gorm:"not null;index"`
+    StorageKey   string    `json:"-" gorm:"not null"`
+    OriginalName string    `json:"original_name"`
+    ContentType  string    `json:"content_type" gorm:"not null"`
+    Size         int64     `json:"size" gorm:"not null"`
+    CreatedAt    time.Time `json:"created_at"`
+}
+
+// Request DTOs
+// Sent as a multipart form so attachments can come along
+type SubmissionRequest struct {
+    Title        string `form:"title" binding:"required,max=200"`
+    Excerpt      string `form:"excerpt" binding:"max=1000"`
+    Content      string `form:"content" binding:"required,max=100000"`
+    Name         string `form:"name" binding:"required,max=100"`
+    Email        string `form:"email" binding:"required,email"`
+    Bio          string `form:"bio" binding:"max=1000"`
+    Language     string `form:"language"`      // Defaults to the site language
+    Website      string `form:"website"`       // Honeypot: hidden from people, bots fill it in
+    CaptchaToken string `form:"captcha_token"` // From the captcha widget
+}
+
+type SubmissionStatusRequest struct {
+    Status  string `json:"status" binding:"required"` // pending, reviewing or rejected
+    Message string `json:"message"`
+}
+
+type AcceptSubmissionRequest struct {
+    AuthorID *uint  `json:"author_id"` // Credit an existing account instead of the guest's name
+    Message  string `json:"message"`
+}
+
+// Response DTOs
+type SubmissionReceipt struct {
+    Status    string `json:"status"`
+    Token     string `json:"token"`      // Only shown once
+    StatusURL string `json:"status_url"`
+}
+
+// SubmissionStatusResponse is all the guest sees through their secret link
+type SubmissionStatusResponse struct {
+    Title       string `json:"title"`
+    Status      string `json:"status"`
+    Message     string `json:"message,omitempty"`
+    SubmittedAt string `json:"submitted_at"`
+    UpdatedAt   string `json:"updated_at"`
+    PostURL     string `json:"post_url,omitempty"` // Once the accepted article is published
+}
+
+type SubmissionAttachmentResponse struct {
+    ID           uint   `json:"id"`
+    OriginalName string `json:"original_name"`
+    ContentType  string `json:"content_type"`
+    Size         int64  `json:"size"`
+    URL          string `json:"url"` // Editors-only download
+}
+
+type SubmissionResponse struct {
+    ID          uint                           `json:"id"`
+    Title       string                         `json:"title"`
+    Excerpt     string                         `json:"excerpt"`
+    Content     string                         `json:"content,omitempty"` // Only for a single submission
+    Name        string                         `json:"name"`
+    Email       string                         `json:"email"`
+    Bio         string                         `json:"bio"`
+    Language    string                         `json:"language"`
+    Status      string                         `json:"status"`
+    Message     string                         `json:"message"`
+    BlogPostID  *uint                          `json:"blog_post_id"`
+    Attachments []SubmissionAttachmentResponse `json:"attachments"`
+    CreatedAt   string                         `json:"created_at"`
+    UpdatedAt   string                         `json:"updated_at"`
+}
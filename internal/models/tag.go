@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type Tag struct {
+    ID        uint      `json:"id" gorm:"primaryKey"`
+    Name      string    `json:"name" gorm:"not null"`
+    Slug      string    `json:"slug" gorm:"not null;uniqueIndex"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+type TagResponse struct {
+    Name string `json:"name"`
+    Slug string `json:"slug"`
+}
@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// PostTranslation is a post's text in another language. The post itself
+// holds the canonical version, in BlogPost.Language.
+type PostTranslation struct {
+    ID          uint       `json:"id" gorm:"primaryKey"`
+    BlogPostID  uint       `json:"blog_post_id" gorm:"not null;uniqueIndex:idx_post_translation"`
+    Language    string     `json:"language" gorm:"size:8;not null;uniqueIndex:idx_post_translation"`
+    Title       string     `json:"title" gorm:"not null"`
+    Excerpt     string     `json:"excerpt" gorm:"type:text;not null"`
+    Content     string     `json:"content" gorm:"type:text"`
+    Published   bool       `json:"published" gorm:"not null;default:false"`
+    PublishedAt *time.Time `json:"published_at"`
+    CreatedAt   time.Time  `json:"created_at"`
+    UpdatedAt   time.Time  `json:"updated_at"`
+
+    // Computed from Content on every save
+    WordCount      int `json:"word_count" gorm:"not null;default:0"`
+    ReadingMinutes int `json:"reading_minutes" gorm:"not null;default:0"`
+}
+
+// Request DTOs
+type SaveTranslationRequest struct {
+    Title     string `json:"title" binding:"required"`
+    Excerpt   string `json:"excerpt" binding:"required"`
+    Content   string `json:"content"`
+    Published bool   `json:"published"`
+}
+
+// Response DTOs
+type TranslationResponse struct {
+    Language  string `json:"language"`
+    Title     string `json:"title"`
+    Excerpt   string `json:"excerpt"`
+    Content   string `json:"content"`
+    Published bool   `json:"published"`
+    UpdatedAt string `json:"updated_at"`
+
+    WordCount      int `json:"word_count"`
+    ReadingMinutes int `json:"reading_minutes"`
+}
+
+// PostLanguageResponse is one entry in the list of languages a post can be read in
+type PostLanguageResponse struct {
+    Language  string `json:"language"`
+    Title     string `json:"title"`
+    URL       string `json:"url"`
+    Canonical bool   `json:"canonical"`
+    Published bool   `json:"published"`
+}
+
+// SitemapTranslation is a published translation listed as an hreflang alternate
+type SitemapTranslation struct {
+    BlogPostID uint
+    Language   string
+    UpdatedAt  time.Time
+}
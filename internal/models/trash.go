@@ -0,0 +1,17 @@
+package models
+
+// TrashItemResponse is a soft-deleted post or comment
+type TrashItemResponse struct {
+    ID         uint   `json:"id"`
+    Title      string `json:"title"`                  // Post title, or the start of the comment
+    Author     string `json:"author"`                 // Post author or commenter name
+    BlogPostID *uint  `json:"blog_post_id,omitempty"` // Comments only
+    DeletedAt  string `json:"deleted_at"`
+    PurgeAt    string `json:"purge_at,omitempty"` // When the retention job removes it for good
+}
+
+type TrashResponse struct {
+    Posts         []TrashItemResponse `json:"posts"`
+    Comments      []TrashItemResponse `json:"comments"`
+    RetentionDays int                 `json:"retention_days"` // 0 when nothing is purged automatically
+}
@@ -12,11 +12,19 @@ type User struct {
 	 Email string `json:"email" gorm:"uniqueIndex;not null"`
 	 Name string `json:"name" gorm:"not null"`
 	 Picture   string    `json:"picture"`
+	 Role      string    `json:"role" gorm:"not null;default:reader"`
 	 CreatedAt time.Time  `json:"created_at"`
 	 UpdatedAt time.Time `json:"updated_at"`
 	 DeletedAt gorm.DeletedAt `json:"_" gorm:"index"`
 }
 
+// User roles, from least to most privileged
+const (
+    RoleReader = "reader"
+    RoleEditor = "editor"
+    RoleAdmin  = "admin"
+)
+
 // This is what Google will send us
 type GoogleUserInfo struct {
     ID      string `json:"id"`      // Google's user ID
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "time"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type AnalyticsRepositoryInterface interface {
+    AddPostViews(rows []models.PostViewDaily) error
+    AddReferrerViews(rows []models.ReferrerDaily) error
+    AddSiteVisitors(rows []models.SiteVisitorDaily) error
+    TopPosts(from, to time.Time, limit int) ([]models.PostViewTotal, error)
+    TopReferrers(from, to time.Time, limit int) ([]models.ReferrerResponse, error)
+    DailyTotals(from, to time.Time) ([]models.DailyViewTotal, error)
+}
+
+type AnalyticsRepository struct {
+    db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) AnalyticsRepositoryInterface {
+    return &AnalyticsRepository{db: db}
+}
+
+// AddPostViews adds the counts onto any existing rows for the same post and day
+func (r *AnalyticsRepository) AddPostViews(rows []models.PostViewDaily) error {
+    if len(rows) == 0 {
+        return nil
+    }
+    return r.db.Clauses(clause.OnConflict{
+        Columns: []clause.Column{{Name: "blog_post_id"}, {Name: "day"}},
+        DoUpdates: clause.Assignments(map[string]interface{}{
+            "views":    gorm.Expr("post_view_dailies.views + excluded.views"),
+            "visitors": gorm.Expr("post_view_dailies.visitors + excluded.visitors"),
+        }),
+    }).Create(&rows).Error
+}
+
+func (r *AnalyticsRepository) AddReferrerViews(rows []models.ReferrerDaily) error {
+    if len(rows) == 0 {
+        return nil
+    }
+    return r.db.Clauses(clause.OnConflict{
+        Columns: []clause.Column{{Name: "day"}, {Name: "host"}},
+        DoUpdates: clause.Assignments(map[string]interface{}{
+            "views": gorm.Expr("referrer_dailies.views + excluded.views"),
+        }),
+    }).Create(&rows).Error
+}
+
+func (r *AnalyticsRepository) AddSiteVisitors(rows []models.SiteVisitorDaily) error {
+    if len(rows) == 0 {
+        return nil
+    }
+    return r.db.Clauses(clause.OnConflict{
+        Columns: []clause.Column{{Name: "day"}},
+        DoUpdates: clause.Assignments(map[string]interface{}{
+            "visitors": gorm.Expr("site_visitor_dailies.visitors + excluded.visitors"),
+        }),
+    }).Create(&rows).Error
+}
+
+func (r *AnalyticsRepository) TopPosts(from, to time.Time, limit int) ([]models.PostViewTotal, error) {
+    var totals []models.PostViewTotal
+    err := r.db.Model(&models.PostViewDaily{}).
+        Select("blog_post_id, SUM(views) AS views, SUM(visitors) AS visitors").
+        Where("day BETWEEN ? AND ?", from, to).
+        Group("blog_post_id").
+        Order("views DESC, blog_post_id DESC").
+        Limit(limit).
+        Scan(&totals).Error
+    return totals, err
+}
+
+func (r *AnalyticsRepository) TopReferrers(from, to time.Time, limit int) ([]models.ReferrerResponse, error) {
+    var totals []models.ReferrerResponse
+    err := r.db.Model(&models.ReferrerDaily{}).
+        Select("host, SUM(views) AS views").
+        Where("day BETWEEN ? AND ?", from, to).
+        Group("host").
+        Order("views DESC, host ASC").
+        Limit(limit).
+        Scan(&totals).Error
+    return totals, err
+}
+
+// DailyTotals sums views over posts, visitors come from the site-wide count so a
+// reader opening several posts counts once
+func (r *AnalyticsRepository) DailyTotals(from, to time.Time) ([]models.DailyViewTotal, error) {
+    var totals []models.DailyViewTotal
+    err := r.db.Model(&models.PostViewDaily{}).
+        Select("post_view_dailies.day, SUM(post_view_dailies.views) AS views, COALESCE(MAX(site_visitor_dailies.visitors), 0) AS visitors").
+        Joins("LEFT JOIN site_visitor_dailies ON site_visitor_dailies.day = post_view_dailies.day").
+        Where("post_view_dailies.day BETWEEN ? AND ?", from, to).
+        Group("post_view_dailies.day").
+        Order("post_view_dailies.day ASC").
+        Scan(&totals).Error
+    return totals, err
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "gorm.io/gorm"
+)
+
+type AudioRepositoryInterface interface {
+    GetByBlogPostID(blogPostID uint) (*models.PostAudio, error)
+    Save(audio *models.PostAudio) error
+    Delete(id uint) error
+    CountByKey(key string) (int64, error)
+    NextEpisodeNumber() (int, error)
+}
+
+type AudioRepository struct {
+    db *gorm.DB
+}
+
+func NewAudioRepository(db *gorm.DB) AudioRepositoryInterface {
+    return &AudioRepository{db: db}
+}
+
+func (r *AudioRepository) GetByBlogPostID(blogPostID uint) (*models.PostAudio, error) {
+    var audio models.PostAudio
+    err := r.db.Where("blog_post_id = ?", blogPostID).First(&audio).Error
+    if err != nil {
+        return nil, err
+    }
+    return &audio, nil
+}
+
+// Save creates the record, or updates it when ID is set
+func (r *AudioRepository) Save(audio *models.PostAudio) error {
+    return r.db.Save(audio).Error
+}
+
+func (r *AudioRepository) Delete(id uint) error {
+    return r.db.Delete(&models.PostAudio{}, id).Error
+}
+
+func (r *AudioRepository) CountByKey(key string) (int64, error) {
+    var count int64
+    err := r.db.Model(&models.PostAudio{}).Where("key = ?", key).Count(&count).Error
+    return count, err
+}
+
+func (r *AudioRepository) NextEpisodeNumber() (int, error) {
+    var max *int
+    err := r.db.Model(&models.PostAudio{}).Select("MAX(episode)").Scan(&max).Error
+    if err != nil || max == nil {
+        return 1, err
+    }
+    return *max + 1, nil
+}
@@ -2,7 +2,10 @@ package repositories
 
 import (
 	 "auth2_google/internal/models"
+	 "time"
+
 	 "gorm.io/gorm"
+	 "gorm.io/gorm/clause"
 )
 
 
@@ -11,8 +14,21 @@ type BlogRepositoryInterface interface {
 	 GetAll() ([]models.BlogPost, error)
 	 GetByID(id uint) (*models.BlogPost, error)
 	 Update(post *models.BlogPost) error 
-	 Delete(id uint) error
+	 Delete(id uint, archiveComments bool) error
 	 GetPublished() ([]models.BlogPost, error) 
+	 GetByIDs(ids []uint) ([]models.BlogPost, error)
+	 ReplaceAuthors(postID uint, authors []models.PostAuthor) error
+	 GetWithAudio() ([]models.BlogPost, error)
+	 ReplaceTags(post *models.BlogPost, tags []models.Tag) error
+	 GetPublishedForFeed(filter models.FeedFilter, limit int) ([]models.BlogPost, error)
+	 GetSitemapPosts() ([]models.SitemapPost, error)
+	 IsPublished(id uint) (bool, error)
+	 GetPublishedForRelated() ([]models.BlogPost, error)
+	 Transaction(fn func(repo BlogRepositoryInterface) error) error
+	 SetReadingStats(id uint, words, minutes int) error
+	 RevokePreviews(id uint) error
+	 Touch(id uint) error
+	 LastChange() (time.Time, error)
 }
 
 type blogRepository struct {
@@ -25,17 +41,44 @@ func NewBlogRepository(db *gorm.DB) BlogRepositoryInterface {
 	}
 }
 
+// Load authors in display order together with their accounts
+func withAuthors(db *gorm.DB) *gorm.DB {
+    return db.Preload("Authors", func(db *gorm.DB) *gorm.DB {
+        return db.Order("position ASC")
+    }).Preload("Authors.User")
+}
+
+func withImage(db *gorm.DB) *gorm.DB {
+    return db.Preload("ImageMedia.Variants")
+}
+
+func withAudio(db *gorm.DB) *gorm.DB {
+    return db.Preload("Audio")
+}
+
+func withTags(db *gorm.DB) *gorm.DB {
+    return db.Preload("Tags", func(db *gorm.DB) *gorm.DB {
+        return db.Order("tags.name ASC")
+    })
+}
+
+func withTranslations(db *gorm.DB) *gorm.DB {
+    return db.Preload("Translations", func(db *gorm.DB) *gorm.DB {
+        return db.Order("language ASC")
+    })
+}
+
 func(r *blogRepository) Create(post *models.BlogPost) error {
 	return r.db.Create(post).Error 
 }
 func (r *blogRepository) GetAll() ([]models.BlogPost, error) {
     var posts []models.BlogPost
-    err := r.db.Order("created_at DESC").Find(&posts).Error
+    err := r.db.Scopes(withAuthors, withImage, withAudio, withTags, withTranslations).Order("created_at DESC").Find(&posts).Error
     return posts, err
 }
 func (r *blogRepository) GetByID(id uint) (*models.BlogPost, error) {
     var post models.BlogPost
-    err := r.db.First(&post, id).Error
+    err := r.db.Scopes(withAuthors, withImage, withAudio, withTags, withTranslations).First(&post, id).Error
     if err != nil {
         return nil, err
     }
@@ -43,15 +86,165 @@ func (r *blogRepository) GetByID(id uint) (*models.BlogPost, error) {
 }
 
 func (r *blogRepository) Update(post *models.BlogPost) error {
-    return r.db.Save(post).Error
+    // Associations are managed separately, e.g. through ReplaceAuthors
+    return r.db.Omit(clause.Associations).Save(post).Error
 }
 
-func (r *blogRepository) Delete(id uint) error {
-    return r.db.Delete(&models.BlogPost{}, id).Error
+// Delete moves the post to the trash together with its comments, or archives the
+// comments instead. Both carry the post's deletion time so a restore can find them.
+func (r *blogRepository) Delete(id uint, archiveComments bool) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        now := time.Now()
+
+        comments := tx.Model(&models.Comment{}).Where("blog_post_id = ?", id)
+        if archiveComments {
+            comments = comments.Where("archived_at IS NULL").UpdateColumn("archived_at", now)
+        } else {
+            comments = comments.UpdateColumn("deleted_at", now)
+        }
+        if comments.Error != nil {
+            return comments.Error
+        }
+
+        return tx.Model(&models.BlogPost{}).Where("id = ?", id).UpdateColumn("deleted_at", now).Error
+    })
 }
 
 func (r *blogRepository) GetPublished() ([]models.BlogPost, error) {
     var posts []models.BlogPost
-    err := r.db.Where("published = ?", true).Order("created_at DESC").Find(&posts).Error
+    err := r.db.Scopes(withAuthors, withImage, withAudio, withTags, withTranslations).Where("published = ?", true).Order("created_at DESC").Find(&posts).Error
     return posts, err
-}
\ No newline at end of file
+}
+
+func (r *blogRepository) GetByIDs(ids []uint) ([]models.BlogPost, error) {
+    var posts []models.BlogPost
+    if len(ids) == 0 {
+        return posts, nil
+    }
+    err := r.db.Scopes(withAuthors, withImage, withAudio, withTags, withTranslations).Where("id IN ?", ids).Find(&posts).Error
+    return posts, err
+}
+
+func (r *blogRepository) ReplaceAuthors(postID uint, authors []models.PostAuthor) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("blog_post_id = ?", postID).Delete(&models.PostAuthor{}).Error; err != nil {
+            return err
+        }
+        if len(authors) == 0 {
+            return nil
+        }
+        for i := range authors {
+            authors[i].BlogPostID = postID
+        }
+        return tx.Omit("User").Create(&authors).Error
+    })
+}
+
+// Published posts that have a narration attached, newest first
+func (r *blogRepository) GetWithAudio() ([]models.BlogPost, error) {
+    var posts []models.BlogPost
+    err := r.db.Scopes(withAuthors, withImage, withAudio, withTags).
+        Joins("JOIN post_audios ON post_audios.blog_post_id = blog_posts.id").
+        Where("blog_posts.published = ?", true).
+        Order("blog_posts.created_at DESC").
+        Find(&posts).Error
+    return posts, err
+}
+
+func (r *blogRepository) ReplaceTags(post *models.BlogPost, tags []models.Tag) error {
+    return r.db.Model(post).Association("Tags").Replace(tags)
+}
+
+// Published posts for syndication, newest first, optionally limited to a tag or author
+func (r *blogRepository) GetPublishedForFeed(filter models.FeedFilter, limit int) ([]models.BlogPost, error) {
+    var posts []models.BlogPost
+    query := r.db.Scopes(withAuthors, withImage, withAudio, withTags).
+        Where("blog_posts.published = ?", true)
+
+    if filter.TagSlug != "" {
+        query = query.Where("blog_posts.id IN (?)", r.db.Table("post_tags").
+            Select("post_tags.blog_post_id").
+            Joins("JOIN tags ON tags.id = post_tags.tag_id").
+            Where("tags.slug = ?", filter.TagSlug))
+    }
+    if filter.AuthorID != 0 {
+        // Co-authored posts count too
+        query = query.Where("blog_posts.author_id = ? OR blog_posts.id IN (?)", filter.AuthorID, r.db.Model(&models.PostAuthor{}).
+            Select("blog_post_id").
+            Where("user_id = ?", filter.AuthorID))
+    }
+
+    err := query.Order("blog_posts.published_at DESC, blog_posts.created_at DESC").
+        Limit(limit).
+        Find(&posts).Error
+    return posts, err
+}
+
+// Only IDs and dates, the sitemap can list tens of thousands of posts
+func (r *blogRepository) GetSitemapPosts() ([]models.SitemapPost, error) {
+    var posts []models.SitemapPost
+    err := r.db.Model(&models.BlogPost{}).
+        Select("id, language, updated_at").
+        Where("published = ?", true).
+        Order("id ASC").
+        Scan(&posts).Error
+    return posts, err
+}
+
+func (r *blogRepository) IsPublished(id uint) (bool, error) {
+    var count int64
+    err := r.db.Model(&models.BlogPost{}).Where("id = ? AND published = ?", id, true).Count(&count).Error
+    return count > 0, err
+}
+
+// Published posts with just what related-post scoring reads: text, tags and authors
+func (r *blogRepository) GetPublishedForRelated() ([]models.BlogPost, error) {
+    var posts []models.BlogPost
+    err := r.db.Preload("Tags").Preload("Authors").
+        Select("id, title, excerpt, content, author_id").
+        Where("published = ?", true).
+        Find(&posts).Error
+    return posts, err
+}
+
+// Transaction runs fn with a repository whose writes all commit or all roll back
+func (r *blogRepository) Transaction(fn func(repo BlogRepositoryInterface) error) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        return fn(&blogRepository{db: tx})
+    })
+}
+
+// SetReadingStats stores computed counts without touching updated_at
+func (r *blogRepository) SetReadingStats(id uint, words, minutes int) error {
+    return r.db.Model(&models.BlogPost{}).Where("id = ?", id).
+        UpdateColumns(map[string]interface{}{"word_count": words, "reading_minutes": minutes}).Error
+}
+
+// Touch bumps updated_at for changes kept outside the post row, like removed audio
+func (r *blogRepository) Touch(id uint) error {
+    return r.db.Model(&models.BlogPost{}).Where("id = ?", id).UpdateColumn("updated_at", time.Now()).Error
+}
+
+// LastChange is when anything readers see in feeds or the sitemap last changed.
+// Trashed and unpublished posts count too, so taking a post down moves it forward.
+func (r *blogRepository) LastChange() (time.Time, error) {
+    posts := r.db.Unscoped().Model(&models.BlogPost{}).
+        Select("MAX(GREATEST(updated_at, deleted_at, published_at))")
+    translations := r.db.Model(&models.PostTranslation{}).Select("MAX(updated_at)")
+    audio := r.db.Model(&models.PostAudio{}).Select("MAX(updated_at)")
+
+    var last *time.Time
+    if err := r.db.Raw("SELECT GREATEST((?), (?), (?))", posts, translations, audio).Scan(&last).Error; err != nil {
+        return time.Time{}, err
+    }
+    if last == nil {
+        return time.Time{}, nil
+    }
+    return *last, nil
+}
+
+// RevokePreviews invalidates every preview link issued for the post so far
+func (r *blogRepository) RevokePreviews(id uint) error {
+    return r.db.Model(&models.BlogPost{}).Where("id = ?", id).
+        UpdateColumn("preview_version", gorm.Expr("preview_version + 1")).Error
+}
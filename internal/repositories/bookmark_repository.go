@@ -0,0 +1,64 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type BookmarkRepositoryInterface interface {
+    Save(userID, blogPostID uint) error
+    Remove(userID, blogPostID uint) error
+    GetPostIDs(userID uint) ([]uint, error)
+    SavedAmong(userID uint, blogPostIDs []uint) ([]uint, error)
+}
+
+type BookmarkRepository struct {
+    db *gorm.DB
+}
+
+func NewBookmarkRepository(db *gorm.DB) BookmarkRepositoryInterface {
+    return &BookmarkRepository{db: db}
+}
+
+// Save bookmarks a post, saving it again changes nothing
+func (r *BookmarkRepository) Save(userID, blogPostID uint) error {
+    return r.db.Clauses(clause.OnConflict{DoNothing: true}).
+        Create(&models.Bookmark{UserID: userID, BlogPostID: blogPostID}).Error
+}
+
+// Remove unsaves a post and takes it out of the user's reading lists
+func (r *BookmarkRepository) Remove(userID, blogPostID uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        err := tx.Where("blog_post_id = ? AND reading_list_id IN (?)", blogPostID,
+            tx.Model(&models.ReadingList{}).Select("id").Where("user_id = ?", userID)).
+            Delete(&models.ReadingListItem{}).Error
+        if err != nil {
+            return err
+        }
+        return tx.Where("user_id = ? AND blog_post_id = ?", userID, blogPostID).Delete(&models.Bookmark{}).Error
+    })
+}
+
+// Most recently saved first
+func (r *BookmarkRepository) GetPostIDs(userID uint) ([]uint, error) {
+    var ids []uint
+    err := r.db.Model(&models.Bookmark{}).
+        Where("user_id = ?", userID).
+        Order("created_at DESC, id DESC").
+        Pluck("blog_post_id", &ids).Error
+    return ids, err
+}
+
+// SavedAmong returns which of the posts the user has saved
+func (r *BookmarkRepository) SavedAmong(userID uint, blogPostIDs []uint) ([]uint, error) {
+    var ids []uint
+    if len(blogPostIDs) == 0 {
+        return ids, nil
+    }
+    err := r.db.Model(&models.Bookmark{}).
+        Where("user_id = ? AND blog_post_id IN ?", userID, blogPostIDs).
+        Pluck("blog_post_id", &ids).Error
+    return ids, err
+}
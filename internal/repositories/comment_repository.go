@@ -5,6 +5,15 @@ import (
     "gorm.io/gorm"
 )
 
+// Only comments that aren't archived and whose post is live
+func visibleComments(db *gorm.DB) *gorm.DB {
+    return db.Where("comments.archived_at IS NULL AND comments.blog_post_id IN (SELECT id FROM blog_posts WHERE deleted_at IS NULL)")
+}
+
+func withReplies(db *gorm.DB) *gorm.DB {
+    return db.Preload("Replies", visibleComments)
+}
+
 type CommentRepositoryInterface interface {
     Create(comment *models.Comment) error
     GetByBlogPostID(blogPostID uint) ([]models.Comment, error)
@@ -30,8 +39,8 @@ func (r *CommentRepository) GetByBlogPostID(blogPostID uint) ([]models.Comment,
     var comments []models.Comment
     
     // Get only main comments (ParentID is null) with their replies
-    err := r.db.Where("blog_post_id = ? AND parent_id IS NULL", blogPostID).
-        Preload("Replies").
+    err := r.db.Scopes(visibleComments, withReplies).
+        Where("blog_post_id = ? AND parent_id IS NULL", blogPostID).
         Order("created_at DESC").
         Find(&comments).Error
     
@@ -40,7 +49,7 @@ func (r *CommentRepository) GetByBlogPostID(blogPostID uint) ([]models.Comment,
 
 func (r *CommentRepository) GetByID(id uint) (*models.Comment, error) {
     var comment models.Comment
-    err := r.db.Preload("Replies").First(&comment, id).Error
+    err := r.db.Scopes(visibleComments, withReplies).First(&comment, id).Error
     return &comment, err
 }
 
@@ -54,7 +63,7 @@ func (r *CommentRepository) Delete(id uint) error {
 
 func (r *CommentRepository) GetReplies(parentID uint) ([]models.Comment, error) {
     var replies []models.Comment
-    err := r.db.Where("parent_id = ?", parentID).
+    err := r.db.Scopes(visibleComments).Where("parent_id = ?", parentID).
         Order("created_at ASC").
         Find(&replies).Error
     return replies, err
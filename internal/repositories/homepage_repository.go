@@ -0,0 +1,106 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "time"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type HomepageRepositoryInterface interface {
+    SetFeatured(featured *models.FeaturedPost) error
+    RemoveFeatured(blogPostID uint) error
+    GetFeaturedPostIDs(now time.Time) ([]uint, error)
+
+    GetSlots() ([]models.HomepageSlot, error)
+    GetSlotByName(name string) (*models.HomepageSlot, error)
+    SaveSlot(slot *models.HomepageSlot, blogPostIDs []uint) error
+    DeleteSlot(id uint) error
+}
+
+type HomepageRepository struct {
+    db *gorm.DB
+}
+
+func NewHomepageRepository(db *gorm.DB) HomepageRepositoryInterface {
+    return &HomepageRepository{db: db}
+}
+
+// SetFeatured features a post, or changes its position and expiry if it already is
+func (r *HomepageRepository) SetFeatured(featured *models.FeaturedPost) error {
+    return r.db.Clauses(clause.OnConflict{
+        Columns:   []clause.Column{{Name: "blog_post_id"}},
+        DoUpdates: clause.AssignmentColumns([]string{"position", "expires_at", "updated_at"}),
+    }).Create(featured).Error
+}
+
+func (r *HomepageRepository) RemoveFeatured(blogPostID uint) error {
+    return r.db.Where("blog_post_id = ?", blogPostID).Delete(&models.FeaturedPost{}).Error
+}
+
+// Featured posts that haven't expired, by position and then most recently featured
+func (r *HomepageRepository) GetFeaturedPostIDs(now time.Time) ([]uint, error) {
+    var ids []uint
+    err := r.db.Model(&models.FeaturedPost{}).
+        Where("expires_at IS NULL OR expires_at > ?", now).
+        Order("position ASC, created_at DESC").
+        Pluck("blog_post_id", &ids).Error
+    return ids, err
+}
+
+func (r *HomepageRepository) GetSlots() ([]models.HomepageSlot, error) {
+    var slots []models.HomepageSlot
+    err := r.db.Preload("Posts", func(db *gorm.DB) *gorm.DB {
+        return db.Order("position ASC")
+    }).Order("position ASC, name ASC").Find(&slots).Error
+    return slots, err
+}
+
+// SaveSlot creates or replaces the slot with the same name, along with its hand-picked posts
+func (r *HomepageRepository) SaveSlot(slot *models.HomepageSlot, blogPostIDs []uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        err := tx.Omit(clause.Associations).Clauses(clause.OnConflict{
+            Columns:   []clause.Column{{Name: "name"}},
+            DoUpdates: clause.AssignmentColumns([]string{"title", "position", "source", "tag_slug", "max_posts", "updated_at"}),
+        }).Create(slot).Error
+        if err != nil {
+            return err
+        }
+        // The ID isn't returned when the conflict path updated an existing row
+        if err := tx.Where("name = ?", slot.Name).First(slot).Error; err != nil {
+            return err
+        }
+
+        if err := tx.Where("homepage_slot_id = ?", slot.ID).Delete(&models.HomepageSlotPost{}).Error; err != nil {
+            return err
+        }
+        if len(blogPostIDs) == 0 {
+            return nil
+        }
+
+        posts := make([]models.HomepageSlotPost, len(blogPostIDs))
+        for i, postID := range blogPostIDs {
+            posts[i] = models.HomepageSlotPost{HomepageSlotID: slot.ID, BlogPostID: postID, Position: i}
+        }
+        return tx.Create(&posts).Error
+    })
+}
+
+func (r *HomepageRepository) GetSlotByName(name string) (*models.HomepageSlot, error) {
+    var slot models.HomepageSlot
+    err := r.db.Where("name = ?", name).First(&slot).Error
+    if err != nil {
+        return nil, err
+    }
+    return &slot, nil
+}
+
+func (r *HomepageRepository) DeleteSlot(id uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("homepage_slot_id = ?", id).Delete(&models.HomepageSlotPost{}).Error; err != nil {
+            return err
+        }
+        return tx.Delete(&models.HomepageSlot{}, id).Error
+    })
+}
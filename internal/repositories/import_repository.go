@@ -0,0 +1,63 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type ImportRepositoryInterface interface {
+    GetLocalIDs(source, kind string) (map[string]uint, error)
+    CreatePost(post *models.BlogPost, source, externalID string) error
+    CreateComment(comment *models.Comment, source, externalID string) error
+    RecordImage(source, url string, mediaID uint) error
+}
+
+type ImportRepository struct {
+    db *gorm.DB
+}
+
+func NewImportRepository(db *gorm.DB) ImportRepositoryInterface {
+    return &ImportRepository{db: db}
+}
+
+// GetLocalIDs maps external IDs already imported from source to local IDs
+func (r *ImportRepository) GetLocalIDs(source, kind string) (map[string]uint, error) {
+    var records []models.ImportRecord
+    err := r.db.Where("source = ? AND kind = ?", source, kind).Find(&records).Error
+    if err != nil {
+        return nil, err
+    }
+
+    ids := map[string]uint{}
+    for _, record := range records {
+        ids[record.ExternalID] = record.LocalID
+    }
+    return ids, nil
+}
+
+// CreatePost saves the post and its import record together, so an
+// interrupted import never leaves a post it would create again
+func (r *ImportRepository) CreatePost(post *models.BlogPost, source, externalID string) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(post).Error; err != nil {
+            return err
+        }
+        return tx.Create(&models.ImportRecord{Source: source, Kind: models.ImportPost, ExternalID: externalID, LocalID: post.ID}).Error
+    })
+}
+
+func (r *ImportRepository) CreateComment(comment *models.Comment, source, externalID string) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(comment).Error; err != nil {
+            return err
+        }
+        return tx.Create(&models.ImportRecord{Source: source, Kind: models.ImportComment, ExternalID: externalID, LocalID: comment.ID}).Error
+    })
+}
+
+func (r *ImportRepository) RecordImage(source, url string, mediaID uint) error {
+    return r.db.Clauses(clause.OnConflict{DoNothing: true}).
+        Create(&models.ImportRecord{Source: source, Kind: models.ImportImage, ExternalID: url, LocalID: mediaID}).Error
+}
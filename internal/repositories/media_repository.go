@@ -0,0 +1,51 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "gorm.io/gorm"
+)
+
+type MediaRepositoryInterface interface {
+    Create(media *models.Media) error
+    GetByID(id uint) (*models.Media, error)
+    GetByUserAndHash(userID uint, hash string) (*models.Media, error)
+    GetByUserID(userID uint) ([]models.Media, error)
+}
+
+type MediaRepository struct {
+    db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) MediaRepositoryInterface {
+    return &MediaRepository{db: db}
+}
+
+func (r *MediaRepository) Create(media *models.Media) error {
+    return r.db.Create(media).Error
+}
+
+func (r *MediaRepository) GetByID(id uint) (*models.Media, error) {
+    var media models.Media
+    err := r.db.Preload("Variants").First(&media, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &media, nil
+}
+
+func (r *MediaRepository) GetByUserAndHash(userID uint, hash string) (*models.Media, error) {
+    var media models.Media
+    err := r.db.Preload("Variants").Where("user_id = ? AND hash = ?", userID, hash).First(&media).Error
+    if err != nil {
+        return nil, err
+    }
+    return &media, nil
+}
+
+func (r *MediaRepository) GetByUserID(userID uint) ([]models.Media, error) {
+    var media []models.Media
+    err := r.db.Preload("Variants").Where("user_id = ?", userID).
+        Order("created_at DESC").
+        Find(&media).Error
+    return media, err
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "gorm.io/gorm"
+)
+
+const podcastSettingsID = 1
+
+type PodcastRepositoryInterface interface {
+    GetSettings() (*models.PodcastSettings, error)
+    SaveSettings(settings *models.PodcastSettings) error
+}
+
+type PodcastRepository struct {
+    db *gorm.DB
+}
+
+func NewPodcastRepository(db *gorm.DB) PodcastRepositoryInterface {
+    return &PodcastRepository{db: db}
+}
+
+// GetSettings returns the stored settings, or defaults when none are saved yet
+func (r *PodcastRepository) GetSettings() (*models.PodcastSettings, error) {
+    settings := models.PodcastSettings{
+        ID:       podcastSettingsID,
+        Title:    "FinBangla Voice",
+        Language: "bn",
+        Category: "News",
+        Type:     "episodic",
+    }
+    err := r.db.Where("id = ?", podcastSettingsID).Limit(1).Find(&settings).Error
+    return &settings, err
+}
+
+func (r *PodcastRepository) SaveSettings(settings *models.PodcastSettings) error {
+    settings.ID = podcastSettingsID
+    return r.db.Save(settings).Error
+}
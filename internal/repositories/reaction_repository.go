@@ -0,0 +1,59 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type ReactionRepositoryInterface interface {
+    Set(reaction *models.PostReaction) error
+    Remove(blogPostID uint, reader, reaction string) error
+    GetByReader(blogPostID uint, reader string) (*models.PostReaction, error)
+    CountByPosts(blogPostIDs []uint) ([]models.ReactionCount, error)
+}
+
+type ReactionRepository struct {
+    db *gorm.DB
+}
+
+func NewReactionRepository(db *gorm.DB) ReactionRepositoryInterface {
+    return &ReactionRepository{db: db}
+}
+
+// Set stores the reader's reaction, replacing any earlier one on the same post
+func (r *ReactionRepository) Set(reaction *models.PostReaction) error {
+    return r.db.Clauses(clause.OnConflict{
+        Columns:   []clause.Column{{Name: "blog_post_id"}, {Name: "reader"}},
+        DoUpdates: clause.AssignmentColumns([]string{"reaction", "updated_at"}),
+    }).Create(reaction).Error
+}
+
+// Remove deletes the reader's reaction if it is the given one. Nothing to remove is not an error.
+func (r *ReactionRepository) Remove(blogPostID uint, reader, reaction string) error {
+    return r.db.Where("blog_post_id = ? AND reader = ? AND reaction = ?", blogPostID, reader, reaction).
+        Delete(&models.PostReaction{}).Error
+}
+
+func (r *ReactionRepository) GetByReader(blogPostID uint, reader string) (*models.PostReaction, error) {
+    var reaction models.PostReaction
+    err := r.db.Where("blog_post_id = ? AND reader = ?", blogPostID, reader).First(&reaction).Error
+    if err != nil {
+        return nil, err
+    }
+    return &reaction, nil
+}
+
+func (r *ReactionRepository) CountByPosts(blogPostIDs []uint) ([]models.ReactionCount, error) {
+    var counts []models.ReactionCount
+    if len(blogPostIDs) == 0 {
+        return counts, nil
+    }
+    err := r.db.Model(&models.PostReaction{}).
+        Select("blog_post_id, reaction, COUNT(*) AS count").
+        Where("blog_post_id IN ?", blogPostIDs).
+        Group("blog_post_id, reaction").
+        Scan(&counts).Error
+    return counts, err
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type ReadingListRepositoryInterface interface {
+    Create(list *models.ReadingList) error
+    GetByUserID(userID uint) ([]models.ReadingList, error)
+    GetByID(id uint) (*models.ReadingList, error)
+    Update(list *models.ReadingList) error
+    Delete(id uint) error
+    AddPost(listID, blogPostID uint) error
+    RemovePost(listID, blogPostID uint) error
+    Reorder(listID uint, blogPostIDs []uint) error
+}
+
+type ReadingListRepository struct {
+    db *gorm.DB
+}
+
+func NewReadingListRepository(db *gorm.DB) ReadingListRepositoryInterface {
+    return &ReadingListRepository{db: db}
+}
+
+func withItems(db *gorm.DB) *gorm.DB {
+    return db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+        return db.Order("position ASC, id ASC")
+    })
+}
+
+func (r *ReadingListRepository) Create(list *models.ReadingList) error {
+    return r.db.Create(list).Error
+}
+
+func (r *ReadingListRepository) GetByUserID(userID uint) ([]models.ReadingList, error) {
+    var lists []models.ReadingList
+    err := r.db.Scopes(withItems).Where("user_id = ?", userID).Order("created_at ASC").Find(&lists).Error
+    return lists, err
+}
+
+func (r *ReadingListRepository) GetByID(id uint) (*models.ReadingList, error) {
+    var list models.ReadingList
+    err := r.db.Scopes(withItems).First(&list, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &list, nil
+}
+
+func (r *ReadingListRepository) Update(list *models.ReadingList) error {
+    return r.db.Omit(clause.Associations).Save(list).Error
+}
+
+func (r *ReadingListRepository) Delete(id uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("reading_list_id = ?", id).Delete(&models.ReadingListItem{}).Error; err != nil {
+            return err
+        }
+        return tx.Delete(&models.ReadingList{}, id).Error
+    })
+}
+
+// AddPost appends the post to the end of the list. Adding it twice keeps its place.
+func (r *ReadingListRepository) AddPost(listID, blogPostID uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        var next int
+        err := tx.Model(&models.ReadingListItem{}).
+            Select("COALESCE(MAX(position) + 1, 0)").
+            Where("reading_list_id = ?", listID).
+            Scan(&next).Error
+        if err != nil {
+            return err
+        }
+
+        item := models.ReadingListItem{ReadingListID: listID, BlogPostID: blogPostID, Position: next}
+        return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&item).Error
+    })
+}
+
+func (r *ReadingListRepository) RemovePost(listID, blogPostID uint) error {
+    return r.db.Where("reading_list_id = ? AND blog_post_id = ?", listID, blogPostID).
+        Delete(&models.ReadingListItem{}).Error
+}
+
+// Reorder sets each post's position to its index in blogPostIDs
+func (r *ReadingListRepository) Reorder(listID uint, blogPostIDs []uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        for position, postID := range blogPostIDs {
+            err := tx.Model(&models.ReadingListItem{}).
+                Where("reading_list_id = ? AND blog_post_id = ?", listID, postID).
+                Update("position", position).Error
+            if err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+}
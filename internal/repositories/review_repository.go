@@ -0,0 +1,137 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+
+    "gorm.io/gorm"
+)
+
+type ReviewRepositoryInterface interface {
+    SetStatus(blogPostID uint, status string) error
+    GetReviewers(blogPostID uint) ([]models.PostReviewer, error)
+    GetReviewersForPosts(blogPostIDs []uint) ([]models.PostReviewer, error)
+    ReplaceReviewers(blogPostID uint, reviewers []models.PostReviewer) error
+    GetQueue(statuses []string, reviewerID *uint) ([]models.BlogPost, error)
+    GetNotes(blogPostID uint) ([]models.ReviewNote, error)
+    CountOpenNotes(blogPostIDs []uint) (map[uint]int, error)
+    GetNote(id uint) (*models.ReviewNote, error)
+    CreateNote(note *models.ReviewNote) error
+    UpdateNote(note *models.ReviewNote) error
+    DeleteNote(id uint) error
+}
+
+type ReviewRepository struct {
+    db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepositoryInterface {
+    return &ReviewRepository{db: db}
+}
+
+func (r *ReviewRepository) SetStatus(blogPostID uint, status string) error {
+    return r.db.Model(&models.BlogPost{}).Where("id = ?", blogPostID).Update("review_status", status).Error
+}
+
+// In the order they were assigned
+func (r *ReviewRepository) GetReviewers(blogPostID uint) ([]models.PostReviewer, error) {
+    var reviewers []models.PostReviewer
+    err := r.db.Preload("User").
+        Where("blog_post_id = ?", blogPostID).
+        Order("id ASC").
+        Find(&reviewers).Error
+    return reviewers, err
+}
+
+func (r *ReviewRepository) GetReviewersForPosts(blogPostIDs []uint) ([]models.PostReviewer, error) {
+    var reviewers []models.PostReviewer
+    if len(blogPostIDs) == 0 {
+        return reviewers, nil
+    }
+    err := r.db.Preload("User").
+        Where("blog_post_id IN ?", blogPostIDs).
+        Order("id ASC").
+        Find(&reviewers).Error
+    return reviewers, err
+}
+
+func (r *ReviewRepository) ReplaceReviewers(blogPostID uint, reviewers []models.PostReviewer) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("blog_post_id = ?", blogPostID).Delete(&models.PostReviewer{}).Error; err != nil {
+            return err
+        }
+        if len(reviewers) == 0 {
+            return nil
+        }
+        return tx.Omit("User").Create(&reviewers).Error
+    })
+}
+
+// GetQueue lists posts in the given review states, longest waiting first.
+// With a reviewer, only posts assigned to them are included.
+func (r *ReviewRepository) GetQueue(statuses []string, reviewerID *uint) ([]models.BlogPost, error) {
+    var posts []models.BlogPost
+    query := r.db.Select("id", "title", "author", "review_status", "updated_at").
+        Where("review_status IN ?", statuses)
+    if reviewerID != nil {
+        query = query.Where("id IN (?)",
+            r.db.Model(&models.PostReviewer{}).Select("blog_post_id").Where("user_id = ?", *reviewerID))
+    }
+    err := query.Order("updated_at ASC, id ASC").Find(&posts).Error
+    return posts, err
+}
+
+// Oldest first, so the notes read as a conversation
+func (r *ReviewRepository) GetNotes(blogPostID uint) ([]models.ReviewNote, error) {
+    var notes []models.ReviewNote
+    err := r.db.Preload("Author").
+        Where("blog_post_id = ?", blogPostID).
+        Order("created_at ASC, id ASC").
+        Find(&notes).Error
+    return notes, err
+}
+
+// Unresolved notes per post
+func (r *ReviewRepository) CountOpenNotes(blogPostIDs []uint) (map[uint]int, error) {
+    counts := map[uint]int{}
+    if len(blogPostIDs) == 0 {
+        return counts, nil
+    }
+
+    var rows []struct {
+        BlogPostID uint
+        Count      int
+    }
+    err := r.db.Model(&models.ReviewNote{}).
+        Select("blog_post_id, COUNT(*) AS count").
+        Where("blog_post_id IN ? AND resolved = ?", blogPostIDs, false).
+        Group("blog_post_id").
+        Scan(&rows).Error
+    if err != nil {
+        return nil, err
+    }
+    for _, row := range rows {
+        counts[row.BlogPostID] = row.Count
+    }
+    return counts, nil
+}
+
+func (r *ReviewRepository) GetNote(id uint) (*models.ReviewNote, error) {
+    var note models.ReviewNote
+    err := r.db.Preload("Author").First(&note, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &note, nil
+}
+
+func (r *ReviewRepository) CreateNote(note *models.ReviewNote) error {
+    return r.db.Omit("Author").Create(note).Error
+}
+
+func (r *ReviewRepository) UpdateNote(note *models.ReviewNote) error {
+    return r.db.Omit("Author").Save(note).Error
+}
+
+func (r *ReviewRepository) DeleteNote(id uint) error {
+    return r.db.Delete(&models.ReviewNote{}, id).Error
+}
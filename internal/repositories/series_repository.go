@@ -0,0 +1,111 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type SeriesRepositoryInterface interface {
+    Create(series *models.Series) error
+    GetAll() ([]models.Series, error)
+    GetByID(id uint) (*models.Series, error)
+    GetBySlug(slug string) (*models.Series, error)
+    GetByPostID(blogPostID uint) (*models.Series, error)
+    Update(series *models.Series) error
+    Delete(id uint) error
+    ReplacePosts(seriesID uint, blogPostIDs []uint) error
+}
+
+type SeriesRepository struct {
+    db *gorm.DB
+}
+
+func NewSeriesRepository(db *gorm.DB) SeriesRepositoryInterface {
+    return &SeriesRepository{db: db}
+}
+
+// Load the parts in order, with just enough of each post for navigation links
+func withSeriesPosts(db *gorm.DB) *gorm.DB {
+    return db.Preload("Posts", func(db *gorm.DB) *gorm.DB {
+        return db.Order("position ASC, id ASC")
+    }).Preload("Posts.BlogPost", func(db *gorm.DB) *gorm.DB {
+        return db.Select("id, title, language, published")
+    }).Preload("Posts.BlogPost.Translations")
+}
+
+func (r *SeriesRepository) Create(series *models.Series) error {
+    return r.db.Create(series).Error
+}
+
+func (r *SeriesRepository) GetAll() ([]models.Series, error) {
+    var series []models.Series
+    err := r.db.Scopes(withSeriesPosts).Order("title ASC").Find(&series).Error
+    return series, err
+}
+
+func (r *SeriesRepository) GetByID(id uint) (*models.Series, error) {
+    var series models.Series
+    err := r.db.Scopes(withSeriesPosts).First(&series, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &series, nil
+}
+
+func (r *SeriesRepository) GetBySlug(slug string) (*models.Series, error) {
+    var series models.Series
+    err := r.db.Scopes(withSeriesPosts).Where("slug = ?", slug).First(&series).Error
+    if err != nil {
+        return nil, err
+    }
+    return &series, nil
+}
+
+// GetByPostID returns the series the post is part of, nil when it isn't in one
+func (r *SeriesRepository) GetByPostID(blogPostID uint) (*models.Series, error) {
+    var series []models.Series
+    err := r.db.Scopes(withSeriesPosts).
+        Where("id IN (?)", r.db.Model(&models.SeriesPost{}).Select("series_id").Where("blog_post_id = ?", blogPostID)).
+        Limit(1).
+        Find(&series).Error
+    if err != nil || len(series) == 0 {
+        return nil, err
+    }
+    return &series[0], nil
+}
+
+func (r *SeriesRepository) Update(series *models.Series) error {
+    return r.db.Omit(clause.Associations).Save(series).Error
+}
+
+func (r *SeriesRepository) Delete(id uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("series_id = ?", id).Delete(&models.SeriesPost{}).Error; err != nil {
+            return err
+        }
+        return tx.Delete(&models.Series{}, id).Error
+    })
+}
+
+// ReplacePosts sets the series' parts in order. Posts are taken out of any other series first.
+func (r *SeriesRepository) ReplacePosts(seriesID uint, blogPostIDs []uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Where("series_id = ?", seriesID).Delete(&models.SeriesPost{}).Error; err != nil {
+            return err
+        }
+        if len(blogPostIDs) == 0 {
+            return nil
+        }
+        if err := tx.Where("blog_post_id IN ?", blogPostIDs).Delete(&models.SeriesPost{}).Error; err != nil {
+            return err
+        }
+
+        parts := make([]models.SeriesPost, len(blogPostIDs))
+        for i, postID := range blogPostIDs {
+            parts[i] = models.SeriesPost{SeriesID: seriesID, BlogPostID: postID, Position: i}
+        }
+        return tx.Omit("BlogPost").Create(&parts).Error
+    })
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "time"
+
+    "gorm.io/gorm"
+)
+
+type SubmissionRepositoryInterface interface {
+    Create(submission *models.Submission) error
+    GetAll(status string) ([]models.Submission, error)
+    GetByID(id uint) (*models.Submission, error)
+    GetByTokenHash(hash string) (*models.Submission, error)
+    Update(submission *models.Submission) error
+    Accept(submission *models.Submission, post *models.BlogPost) error
+    CountByVisitorSince(visitorHash string, since time.Time) (int64, error)
+}
+
+type SubmissionRepository struct {
+    db *gorm.DB
+}
+
+func NewSubmissionRepository(db *gorm.DB) SubmissionRepositoryInterface {
+    return &SubmissionRepository{db: db}
+}
+
+// Create saves the submission together with its attachments
+func (r *SubmissionRepository) Create(submission *models.Submission) error {
+    return r.db.Create(submission).Error
+}
+
+// Newest first, optionally only one status. Content is left out of lists.
+func (r *SubmissionRepository) GetAll(status string) ([]models.Submission, error) {
+    var submissions []models.Submission
+    query := r.db.Omit("content").Preload("Attachments")
+    if status != "" {
+        query = query.Where("status = ?", status)
+    }
+    err := query.Order("created_at DESC, id DESC").Find(&submissions).Error
+    return submissions, err
+}
+
+func (r *SubmissionRepository) GetByID(id uint) (*models.Submission, error) {
+    var submission models.Submission
+    err := r.db.Preload("Attachments").First(&submission, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &submission, nil
+}
+
+func (r *SubmissionRepository) GetByTokenHash(hash string) (*models.Submission, error) {
+    var submission models.Submission
+    err := r.db.Where("token_hash = ?", hash).First(&submission).Error
+    if err != nil {
+        return nil, err
+    }
+    return &submission, nil
+}
+
+func (r *SubmissionRepository) Update(submission *models.Submission) error {
+    return r.db.Omit("Attachments").Save(submission).Error
+}
+
+// Accept saves the draft post and links the submission to it together, so a
+// failure never leaves a pending submission whose post already exists
+func (r *SubmissionRepository) Accept(submission *models.Submission, post *models.BlogPost) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        if err := tx.Create(post).Error; err != nil {
+            return err
+        }
+        submission.Status = models.SubmissionAccepted
+        submission.BlogPostID = &post.ID
+        return tx.Omit("Attachments").Save(submission).Error
+    })
+}
+
+func (r *SubmissionRepository) CountByVisitorSince(visitorHash string, since time.Time) (int64, error) {
+    var count int64
+    err := r.db.Model(&models.Submission{}).
+        Where("visitor_hash = ? AND created_at >= ?", visitorHash, since).
+        Count(&count).Error
+    return count, err
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/utils"
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+type TagRepositoryInterface interface {
+    FindOrCreate(names []string) ([]models.Tag, error)
+    GetBySlug(slug string) (*models.Tag, error)
+}
+
+type TagRepository struct {
+    db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepositoryInterface {
+    return &TagRepository{db: db}
+}
+
+// FindOrCreate returns the tags for the given names in order, creating missing ones.
+// Names that slugify to the same slug are treated as one tag.
+func (r *TagRepository) FindOrCreate(names []string) ([]models.Tag, error) {
+    tags := []models.Tag{}
+    seen := map[string]bool{}
+
+    for _, name := range names {
+        slug := utils.Slugify(name)
+        if slug == "" || seen[slug] {
+            continue
+        }
+        seen[slug] = true
+
+        tag := models.Tag{Name: name, Slug: slug}
+        err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&tag).Error
+        if err != nil {
+            return nil, err
+        }
+        if err := r.db.Where("slug = ?", slug).First(&tag).Error; err != nil {
+            return nil, err
+        }
+        tags = append(tags, tag)
+    }
+    return tags, nil
+}
+
+func (r *TagRepository) GetBySlug(slug string) (*models.Tag, error) {
+    var tag models.Tag
+    err := r.db.Where("slug = ?", slug).First(&tag).Error
+    if err != nil {
+        return nil, err
+    }
+    return &tag, nil
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "gorm.io/gorm"
+)
+
+type TranslationRepositoryInterface interface {
+    GetByBlogPostID(blogPostID uint) ([]models.PostTranslation, error)
+    Get(blogPostID uint, language string) (*models.PostTranslation, error)
+    Save(translation *models.PostTranslation) error
+    Delete(id uint) error
+    GetPublishedForSitemap() ([]models.SitemapTranslation, error)
+    SetReadingStats(id uint, words, minutes int) error
+}
+
+type TranslationRepository struct {
+    db *gorm.DB
+}
+
+func NewTranslationRepository(db *gorm.DB) TranslationRepositoryInterface {
+    return &TranslationRepository{db: db}
+}
+
+func (r *TranslationRepository) GetByBlogPostID(blogPostID uint) ([]models.PostTranslation, error) {
+    var translations []models.PostTranslation
+    err := r.db.Where("blog_post_id = ?", blogPostID).Order("language ASC").Find(&translations).Error
+    return translations, err
+}
+
+func (r *TranslationRepository) Get(blogPostID uint, language string) (*models.PostTranslation, error) {
+    var translation models.PostTranslation
+    err := r.db.Where("blog_post_id = ? AND language = ?", blogPostID, language).First(&translation).Error
+    if err != nil {
+        return nil, err
+    }
+    return &translation, nil
+}
+
+// Save creates the record, or updates it when ID is set
+func (r *TranslationRepository) Save(translation *models.PostTranslation) error {
+    return r.db.Save(translation).Error
+}
+
+func (r *TranslationRepository) Delete(id uint) error {
+    return r.db.Delete(&models.PostTranslation{}, id).Error
+}
+
+// Published translations of published posts, without their text
+func (r *TranslationRepository) GetPublishedForSitemap() ([]models.SitemapTranslation, error) {
+    var translations []models.SitemapTranslation
+    err := r.db.Model(&models.PostTranslation{}).
+        Select("post_translations.blog_post_id, post_translations.language, post_translations.updated_at").
+        Joins("JOIN blog_posts ON blog_posts.id = post_translations.blog_post_id").
+        Where("post_translations.published = ? AND blog_posts.published = ? AND blog_posts.deleted_at IS NULL", true, true).
+        Order("post_translations.blog_post_id ASC, post_translations.language ASC").
+        Scan(&translations).Error
+    return translations, err
+}
+
+// SetReadingStats stores computed counts without touching updated_at
+func (r *TranslationRepository) SetReadingStats(id uint, words, minutes int) error {
+    return r.db.Model(&models.PostTranslation{}).Where("id = ?", id).
+        UpdateColumns(map[string]interface{}{"word_count": words, "reading_minutes": minutes}).Error
+}
@@ -0,0 +1,170 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "time"
+
+    "gorm.io/gorm"
+)
+
+// Rows that belong to a post and go with it when it is purged
+var postDependents = []interface{}{
+    &models.PostAuthor{},
+    &models.PostTranslation{},
+    &models.PostAudio{},
+    &models.PostReaction{},
+    &models.Bookmark{},
+    &models.ReadingListItem{},
+    &models.SeriesPost{},
+    &models.FeaturedPost{},
+    &models.HomepageSlotPost{},
+    &models.PostViewDaily{},
+    &models.PostReviewer{},
+    &models.ReviewNote{},
+}
+
+// Every comment in the thread under the given comment, itself included
+const commentThreadSQL = `WITH RECURSIVE thread AS (
+    SELECT id FROM comments WHERE id = ?
+    UNION ALL
+    SELECT comments.id FROM comments JOIN thread ON comments.parent_id = thread.id
+)`
+
+type TrashRepositoryInterface interface {
+    GetDeletedPosts() ([]models.BlogPost, error)
+    GetDeletedComments() ([]models.Comment, error)
+    GetDeletedPost(id uint) (*models.BlogPost, error)
+    GetDeletedComment(id uint) (*models.Comment, error)
+    RestorePost(id uint) error
+    RestoreComment(id uint) error
+    PurgePost(id uint) error
+    PurgeComment(id uint) error
+    GetExpiredPostIDs(before time.Time) ([]uint, error)
+    GetExpiredCommentIDs(before time.Time) ([]uint, error)
+}
+
+type TrashRepository struct {
+    db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) TrashRepositoryInterface {
+    return &TrashRepository{db: db}
+}
+
+// Most recently deleted first
+func (r *TrashRepository) GetDeletedPosts() ([]models.BlogPost, error) {
+    var posts []models.BlogPost
+    err := r.db.Unscoped().
+        Select("id, title, author, deleted_at").
+        Where("deleted_at IS NOT NULL").
+        Order("deleted_at DESC").
+        Find(&posts).Error
+    return posts, err
+}
+
+// Comments that went with their post are listed under the post, not here
+func (r *TrashRepository) GetDeletedComments() ([]models.Comment, error) {
+    var comments []models.Comment
+    err := r.db.Unscoped().
+        Where("deleted_at IS NOT NULL").
+        Where("blog_post_id IN (SELECT id FROM blog_posts WHERE deleted_at IS NULL)").
+        Order("deleted_at DESC").
+        Find(&comments).Error
+    return comments, err
+}
+
+func (r *TrashRepository) GetDeletedPost(id uint) (*models.BlogPost, error) {
+    var post models.BlogPost
+    err := r.db.Unscoped().Where("deleted_at IS NOT NULL").First(&post, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &post, nil
+}
+
+func (r *TrashRepository) GetDeletedComment(id uint) (*models.Comment, error) {
+    var comment models.Comment
+    err := r.db.Unscoped().Where("deleted_at IS NOT NULL").First(&comment, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &comment, nil
+}
+
+// RestorePost brings back the post and the comments deleted or archived with it.
+// Comments deleted on their own before that stay in the trash.
+func (r *TrashRepository) RestorePost(id uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        deletedAt := tx.Unscoped().Model(&models.BlogPost{}).Select("deleted_at").Where("id = ?", id)
+
+        err := tx.Unscoped().Model(&models.Comment{}).
+            Where("blog_post_id = ? AND deleted_at = (?)", id, deletedAt).
+            UpdateColumn("deleted_at", nil).Error
+        if err != nil {
+            return err
+        }
+        err = tx.Unscoped().Model(&models.Comment{}).
+            Where("blog_post_id = ? AND archived_at = (?)", id, deletedAt).
+            UpdateColumn("archived_at", nil).Error
+        if err != nil {
+            return err
+        }
+
+        // updated_at moves so feeds see the post coming back
+        return tx.Unscoped().Model(&models.BlogPost{}).Where("id = ?", id).
+            UpdateColumns(map[string]interface{}{"deleted_at": nil, "updated_at": time.Now()}).Error
+    })
+}
+
+func (r *TrashRepository) RestoreComment(id uint) error {
+    return r.db.Unscoped().Model(&models.Comment{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// PurgePost removes the post for good, with everything attached to it. Archived
+// comments move to archived_comments first, which doesn't reference the post.
+func (r *TrashRepository) PurgePost(id uint) error {
+    return r.db.Transaction(func(tx *gorm.DB) error {
+        for _, model := range postDependents {
+            if err := tx.Where("blog_post_id = ?", id).Delete(model).Error; err != nil {
+                return err
+            }
+        }
+        if err := tx.Exec("DELETE FROM post_tags WHERE blog_post_id = ?", id).Error; err != nil {
+            return err
+        }
+        err := tx.Exec(`INSERT INTO archived_comments (id, blog_post_id, post_title, name, email, text, parent_id, created_at, archived_at)
+            SELECT comments.id, comments.blog_post_id, blog_posts.title, comments.name, comments.email, comments.text, comments.parent_id, comments.created_at, comments.archived_at
+            FROM comments JOIN blog_posts ON blog_posts.id = comments.blog_post_id
+            WHERE comments.blog_post_id = ? AND comments.archived_at IS NOT NULL
+            ON CONFLICT (id) DO NOTHING`, id).Error
+        if err != nil {
+            return err
+        }
+        // One statement, so replies and their parents go together
+        if err := tx.Unscoped().Where("blog_post_id = ?", id).Delete(&models.Comment{}).Error; err != nil {
+            return err
+        }
+        return tx.Unscoped().Delete(&models.BlogPost{}, id).Error
+    })
+}
+
+// PurgeComment removes the comment for good, with the replies under it
+func (r *TrashRepository) PurgeComment(id uint) error {
+    return r.db.Exec(commentThreadSQL+" DELETE FROM comments WHERE id IN (SELECT id FROM thread)", id).Error
+}
+
+func (r *TrashRepository) GetExpiredPostIDs(before time.Time) ([]uint, error) {
+    var ids []uint
+    err := r.db.Unscoped().Model(&models.BlogPost{}).
+        Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+        Pluck("id", &ids).Error
+    return ids, err
+}
+
+func (r *TrashRepository) GetExpiredCommentIDs(before time.Time) ([]uint, error) {
+    var ids []uint
+    err := r.db.Unscoped().Model(&models.Comment{}).
+        Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+        Pluck("id", &ids).Error
+    return ids, err
+}
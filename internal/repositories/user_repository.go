@@ -0,0 +1,47 @@
+package repositories
+
+import (
+    "auth2_google/internal/models"
+    "gorm.io/gorm"
+)
+
+type UserRepositoryInterface interface {
+    GetByID(id uint) (*models.User, error)
+    GetByIDs(ids []uint) ([]models.User, error)
+    GetByEmail(email string) (*models.User, error)
+}
+
+type UserRepository struct {
+    db *gorm.DB
+}
+
+func NewUserRepository(db *gorm.DB) UserRepositoryInterface {
+    return &UserRepository{db: db}
+}
+
+func (r *UserRepository) GetByID(id uint) (*models.User, error) {
+    var user models.User
+    err := r.db.First(&user, id).Error
+    if err != nil {
+        return nil, err
+    }
+    return &user, nil
+}
+
+func (r *UserRepository) GetByIDs(ids []uint) ([]models.User, error) {
+    var users []models.User
+    if len(ids) == 0 {
+        return users, nil
+    }
+    err := r.db.Where("id IN ?", ids).Find(&users).Error
+    return users, err
+}
+
+func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
+    var user models.User
+    err := r.db.Where("LOWER(email) = LOWER(?)", email).First(&user).Error
+    if err != nil {
+        return nil, err
+    }
+    return &user, nil
+}
@@ -0,0 +1,237 @@
+package search
+
+import (
+    "auth2_google/internal/models"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "math"
+    "os"
+    "path/filepath"
+    "sort"
+    "sync"
+)
+
+// EmbeddedIndex is a small inverted index kept in memory and saved to a JSON file.
+// Meant for local development and tests where Postgres full-text isn't set up.
+// The file may be rewritten by another process (cmd/reindex, cmd/wpimport), so
+// it is read again whenever it was replaced since we last loaded or saved it.
+type EmbeddedIndex struct {
+    path   string
+    mu     sync.RWMutex
+    data   embeddedData
+    loaded os.FileInfo // The file as we last loaded or saved it, nil before that
+}
+
+type embeddedData struct {
+    Docs     map[uint]map[string]int `json:"docs"`     // post ID -> term frequencies
+    Postings map[string][]uint       `json:"postings"` // term -> post IDs
+}
+
+func NewEmbeddedIndex(path string) (SearchIndex, error) {
+    index := &EmbeddedIndex{
+        path: path,
+        data: embeddedData{
+            Docs:     map[uint]map[string]int{},
+            Postings: map[string][]uint{},
+        },
+    }
+    if err := index.reload(); err != nil {
+        return nil, err
+    }
+    return index, nil
+}
+
+// reload reads the file again if someone replaced it. Every save renames a new
+// file into place, so a different file means a different index. Call with mu held.
+func (e *EmbeddedIndex) reload() error {
+    info, err := os.Stat(e.path)
+    if errors.Is(err, os.ErrNotExist) {
+        return nil
+    }
+    if err != nil {
+        return fmt.Errorf("failed to read search index: %v", err)
+    }
+    if e.loaded != nil && os.SameFile(e.loaded, info) {
+        return nil
+    }
+
+    raw, err := os.ReadFile(e.path)
+    if err != nil {
+        return fmt.Errorf("failed to read search index: %v", err)
+    }
+    data := embeddedData{}
+    if err := json.Unmarshal(raw, &data); err != nil {
+        return fmt.Errorf("failed to parse search index: %v", err)
+    }
+    if data.Docs == nil {
+        data.Docs = map[uint]map[string]int{}
+    }
+    if data.Postings == nil {
+        data.Postings = map[string][]uint{}
+    }
+    e.data = data
+    e.loaded = info
+    return nil
+}
+
+func (e *EmbeddedIndex) Index(post models.BlogPost) error {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    if err := e.reload(); err != nil {
+        return err
+    }
+    e.remove(post.ID)
+    e.add(post)
+    return e.save()
+}
+
+func (e *EmbeddedIndex) Remove(id uint) error {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    if err := e.reload(); err != nil {
+        return err
+    }
+    e.remove(id)
+    return e.save()
+}
+
+func (e *EmbeddedIndex) Search(query string, limit int) ([]uint, error) {
+    e.mu.Lock()
+    err := e.reload()
+    e.mu.Unlock()
+    if err != nil {
+        return nil, err
+    }
+
+    e.mu.RLock()
+    defer e.mu.RUnlock()
+
+    terms := tokenize(query)
+    if len(terms) == 0 {
+        return []uint{}, nil
+    }
+
+    // Every term must match, same as plainto_tsquery
+    scores := map[uint]float64{}
+    for i, term := range terms {
+        postings := e.data.Postings[term]
+        idf := math.Log(1 + float64(len(e.data.Docs))/float64(len(postings)+1))
+
+        matched := map[uint]float64{}
+        for _, id := range postings {
+            if i > 0 {
+                if _, ok := scores[id]; !ok {
+                    continue
+                }
+            }
+            matched[id] = scores[id] + float64(e.data.Docs[id][term])*idf
+        }
+        scores = matched
+    }
+
+    ids := make([]uint, 0, len(scores))
+    for id := range scores {
+        ids = append(ids, id)
+    }
+    sort.Slice(ids, func(i, j int) bool {
+        if scores[ids[i]] != scores[ids[j]] {
+            return scores[ids[i]] > scores[ids[j]]
+        }
+        return ids[i] > ids[j] // Newer posts first on ties
+    })
+
+    if limit > 0 && len(ids) > limit {
+        ids = ids[:limit]
+    }
+    return ids, nil
+}
+
+func (e *EmbeddedIndex) Rebuild(posts []models.BlogPost) error {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    e.data.Docs = map[uint]map[string]int{}
+    e.data.Postings = map[string][]uint{}
+    for _, post := range posts {
+        e.add(post)
+    }
+    return e.save()
+}
+
+func (e *EmbeddedIndex) add(post models.BlogPost) {
+    freqs := map[string]int{}
+    for _, term := range tokenize(documentText(post)) {
+        freqs[term]++
+    }
+
+    e.data.Docs[post.ID] = freqs
+    for term := range freqs {
+        e.data.Postings[term] = append(e.data.Postings[term], post.ID)
+    }
+}
+
+func (e *EmbeddedIndex) remove(id uint) {
+    freqs, ok := e.data.Docs[id]
+    if !ok {
+        return
+    }
+
+    for term := range freqs {
+        postings := e.data.Postings[term]
+        for i, postID := range postings {
+            if postID == id {
+                postings = append(postings[:i], postings[i+1:]...)
+                break
+            }
+        }
+        if len(postings) == 0 {
+            delete(e.data.Postings, term)
+        } else {
+            e.data.Postings[term] = postings
+        }
+    }
+    delete(e.data.Docs, id)
+}
+
+// Write to a temp file first so a crash never leaves a half-written index.
+// The temp name is unique so two processes saving at once don't mix their files.
+func (e *EmbeddedIndex) save() error {
+    dir := filepath.Dir(e.path)
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return fmt.Errorf("failed to create search index directory: %v", err)
+    }
+
+    raw, err := json.Marshal(e.data)
+    if err != nil {
+        return fmt.Errorf("failed to encode search index: %v", err)
+    }
+
+    tmp, err := os.CreateTemp(dir, filepath.Base(e.path)+".*.tmp")
+    if err != nil {
+        return fmt.Errorf("failed to write search index: %v", err)
+    }
+    _, err = tmp.Write(raw)
+    if closeErr := tmp.Close(); err == nil {
+        err = closeErr
+    }
+    if err == nil {
+        err = os.Chmod(tmp.Name(), 0644)
+    }
+    if err == nil {
+        err = os.Rename(tmp.Name(), e.path)
+    }
+    if err != nil {
+        os.Remove(tmp.Name())
+        return fmt.Errorf("failed to write search index: %v", err)
+    }
+
+    info, err := os.Stat(e.path)
+    if err != nil {
+        return fmt.Errorf("failed to read search index: %v", err)
+    }
+    e.loaded = info
+    return nil
+}
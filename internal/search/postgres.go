@@ -0,0 +1,59 @@
+package search
+
+import (
+    "auth2_google/internal/models"
+    "log"
+
+    "gorm.io/gorm"
+    "gorm.io/gorm/clause"
+)
+
+// 'simple' config because posts mix Bangla and English, which no single stemmer handles
+const postgresDocument = "to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(excerpt, '') || ' ' || coalesce(content, ''))"
+
+// PostgresIndex queries blog_posts directly, so the table itself is the index
+type PostgresIndex struct {
+    db *gorm.DB
+}
+
+func NewPostgresIndex(db *gorm.DB) SearchIndex {
+    index := &PostgresIndex{db: db}
+    if err := index.ensureIndex(); err != nil {
+        log.Printf("⚠️ Could not create full-text index: %v", err)
+    }
+    return index
+}
+
+func (p *PostgresIndex) ensureIndex() error {
+    return p.db.Exec("CREATE INDEX IF NOT EXISTS idx_blog_posts_search ON blog_posts USING GIN (" + postgresDocument + ")").Error
+}
+
+// Rows are indexed by Postgres on write, nothing to do here
+func (p *PostgresIndex) Index(post models.BlogPost) error {
+    return nil
+}
+
+func (p *PostgresIndex) Remove(id uint) error {
+    return nil
+}
+
+func (p *PostgresIndex) Search(query string, limit int) ([]uint, error) {
+    var ids []uint
+    err := p.db.Model(&models.BlogPost{}).
+        Where(postgresDocument+" @@ plainto_tsquery('simple', ?)", query).
+        Clauses(clause.OrderBy{Expression: clause.Expr{
+            SQL:                "ts_rank(" + postgresDocument + ", plainto_tsquery('simple', ?)) DESC",
+            Vars:               []interface{}{query},
+            WithoutParentheses: true,
+        }}).
+        Limit(limit).
+        Pluck("id", &ids).Error
+    return ids, err
+}
+
+func (p *PostgresIndex) Rebuild(posts []models.BlogPost) error {
+    if err := p.db.Exec("DROP INDEX IF EXISTS idx_blog_posts_search").Error; err != nil {
+        return err
+    }
+    return p.ensureIndex()
+}
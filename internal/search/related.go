@@ -0,0 +1,191 @@
+package search
+
+import (
+    "auth2_google/internal/models"
+    "math"
+    "sort"
+    "sync"
+)
+
+// How much each signal contributes to a related-post score
+const (
+    relatedTagWeight    = 0.5 // Share of tags the two posts have in common
+    relatedAuthorWeight = 0.2 // Any author in common
+    relatedTermWeight   = 0.3 // TF-IDF cosine similarity of the text
+
+    relatedMinScore = 0.05 // Anything below this is noise, not a recommendation
+)
+
+// RelatedIndex scores published posts against each other. It is built lazily from
+// load and thrown away by Invalidate, so it always reflects the latest posts.
+type RelatedIndex struct {
+    load func() ([]models.BlogPost, error)
+
+    mu      sync.Mutex
+    docs    map[uint]*relatedDoc // nil until built
+    results map[uint][]uint      // Memoized rankings per post
+}
+
+type relatedDoc struct {
+    id      uint
+    tags    map[uint]bool
+    authors map[uint]bool
+    terms   map[string]float64 // TF-IDF weights, unit length
+}
+
+func NewRelatedIndex(load func() ([]models.BlogPost, error)) *RelatedIndex {
+    return &RelatedIndex{load: load}
+}
+
+// Invalidate drops the index, the next lookup rebuilds it
+func (r *RelatedIndex) Invalidate() {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    r.docs = nil
+    r.results = nil
+}
+
+// Related returns up to limit post IDs most similar to id, best match first.
+// Posts that aren't in the index (unpublished or unknown) have no related posts.
+func (r *RelatedIndex) Related(id uint, limit int) ([]uint, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+
+    if r.docs == nil {
+        posts, err := r.load()
+        if err != nil {
+            return nil, err
+        }
+        r.docs = buildRelatedDocs(posts)
+        r.results = map[uint][]uint{}
+    }
+
+    ids, ok := r.results[id]
+    if !ok {
+        ids = r.rank(id)
+        r.results[id] = ids
+    }
+
+    if limit > 0 && len(ids) > limit {
+        ids = ids[:limit]
+    }
+    return append([]uint{}, ids...), nil
+}
+
+func (r *RelatedIndex) rank(id uint) []uint {
+    doc, ok := r.docs[id]
+    if !ok {
+        return []uint{}
+    }
+
+    scores := map[uint]float64{}
+    for otherID, other := range r.docs {
+        if otherID == id {
+            continue
+        }
+        score := relatedTagWeight*jaccard(doc.tags, other.tags) +
+            relatedTermWeight*cosine(doc.terms, other.terms)
+        if overlaps(doc.authors, other.authors) {
+            score += relatedAuthorWeight
+        }
+        if score >= relatedMinScore {
+            scores[otherID] = score
+        }
+    }
+
+    ids := make([]uint, 0, len(scores))
+    for otherID := range scores {
+        ids = append(ids, otherID)
+    }
+    sort.Slice(ids, func(i, j int) bool {
+        if scores[ids[i]] != scores[ids[j]] {
+            return scores[ids[i]] > scores[ids[j]]
+        }
+        return ids[i] > ids[j] // Newer posts first on ties
+    })
+    return ids
+}
+
+func buildRelatedDocs(posts []models.BlogPost) map[uint]*relatedDoc {
+    docs := make(map[uint]*relatedDoc, len(posts))
+    freqs := make(map[uint]map[string]int, len(posts))
+    docFreq := map[string]int{}
+
+    for _, post := range posts {
+        doc := &relatedDoc{id: post.ID, tags: map[uint]bool{}, authors: map[uint]bool{}}
+        for _, tag := range post.Tags {
+            doc.tags[tag.ID] = true
+        }
+        if post.AuthorID != nil {
+            doc.authors[*post.AuthorID] = true
+        }
+        for _, author := range post.Authors {
+            doc.authors[author.UserID] = true
+        }
+        docs[post.ID] = doc
+
+        counts := map[string]int{}
+        for _, term := range tokenize(documentText(post)) {
+            counts[term]++
+        }
+        for term := range counts {
+            docFreq[term]++
+        }
+        freqs[post.ID] = counts
+    }
+
+    total := float64(len(posts))
+    for id, counts := range freqs {
+        terms := make(map[string]float64, len(counts))
+        var norm float64
+        for term, count := range counts {
+            weight := (1 + math.Log(float64(count))) * math.Log(total/float64(docFreq[term]))
+            if weight <= 0 {
+                continue // Terms in every post say nothing about similarity
+            }
+            terms[term] = weight
+            norm += weight * weight
+        }
+        norm = math.Sqrt(norm)
+        for term := range terms {
+            terms[term] /= norm
+        }
+        docs[id].terms = terms
+    }
+    return docs
+}
+
+func jaccard(a, b map[uint]bool) float64 {
+    if len(a) == 0 || len(b) == 0 {
+        return 0
+    }
+    shared := 0
+    for id := range a {
+        if b[id] {
+            shared++
+        }
+    }
+    return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+func overlaps(a, b map[uint]bool) bool {
+    for id := range a {
+        if b[id] {
+            return true
+        }
+    }
+    return false
+}
+
+// Both vectors are unit length, so the dot product is the cosine
+func cosine(a, b map[string]float64) float64 {
+    if len(b) < len(a) {
+        a, b = b, a
+    }
+    var dot float64
+    for term, weight := range a {
+        dot += weight * b[term]
+    }
+    return dot
+}
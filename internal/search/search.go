@@ -0,0 +1,55 @@
+package search
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/utils"
+    "fmt"
+    "os"
+    "strings"
+    "unicode"
+
+    "gorm.io/gorm"
+)
+
+// SearchIndex is implemented by every full-text backend for blog posts
+type SearchIndex interface {
+    Index(post models.BlogPost) error
+    Remove(id uint) error
+    Search(query string, limit int) ([]uint, error) // Post IDs, best match first
+    Rebuild(posts []models.BlogPost) error
+}
+
+const defaultIndexPath = "data/search-index.json"
+
+// NewFromEnv picks the backend from SEARCH_BACKEND ("postgres" or "embedded")
+func NewFromEnv(db *gorm.DB) (SearchIndex, error) {
+    backend := os.Getenv("SEARCH_BACKEND")
+    if backend == "" {
+        backend = "postgres"
+    }
+
+    switch backend {
+    case "postgres":
+        return NewPostgresIndex(db), nil
+    case "embedded":
+        path := os.Getenv("SEARCH_INDEX_PATH")
+        if path == "" {
+            path = defaultIndexPath
+        }
+        return NewEmbeddedIndex(path)
+    default:
+        return nil, fmt.Errorf("unknown SEARCH_BACKEND %q", backend)
+    }
+}
+
+// Text that gets indexed for a post
+func documentText(post models.BlogPost) string {
+    return post.Title + " " + post.Excerpt + " " + utils.StripHTML(post.Content)
+}
+
+// Split text into lowercase terms. Marks are kept so Bangla vowel signs stay attached to their word.
+func tokenize(text string) []string {
+    return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+        return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+    })
+}
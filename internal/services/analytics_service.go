@@ -0,0 +1,286 @@
+package services
+
+import (
+    "auth2_google/internal/analytics"
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "errors"
+    "fmt"
+    "log"
+    "net/url"
+    "strings"
+    "sync"
+    "time"
+)
+
+const (
+    analyticsTopLimit = 10
+    analyticsMaxDays  = 365
+)
+
+type AnalyticsServiceInterface interface {
+    RecordView(postID uint, ip, userAgent, referrer string) error
+    Run(interval time.Duration)
+    Flush() error
+    GetReport(days int) (*models.AnalyticsResponse, error)
+}
+
+type postDay struct {
+    postID uint
+    day    time.Time
+}
+
+type referrerDay struct {
+    host string
+    day  time.Time
+}
+
+type viewCount struct {
+    views, visitors int64
+}
+
+// AnalyticsService counts views in memory and writes them out in batches, so
+// reading an article never waits on a database write. Views buffered since the
+// last flush are lost if the process dies.
+type AnalyticsService struct {
+    blogRepo      repositories.BlogRepositoryInterface
+    analyticsRepo repositories.AnalyticsRepositoryInterface
+    hasher        *analytics.VisitorHasher
+
+    mu        sync.Mutex
+    posts     map[postDay]*viewCount
+    referrers map[referrerDay]int64
+    visitors  map[time.Time]int64 // Distinct visitors across the site, per day
+    seenDay   time.Time
+    seen      map[string]bool // post ID (or "site") + visitor hash, for today only
+}
+
+func NewAnalyticsService(blogRepo repositories.BlogRepositoryInterface, analyticsRepo repositories.AnalyticsRepositoryInterface) AnalyticsServiceInterface {
+    return &AnalyticsService{
+        blogRepo:      blogRepo,
+        analyticsRepo: analyticsRepo,
+        hasher:        analytics.NewVisitorHasher(),
+        posts:         map[postDay]*viewCount{},
+        referrers:     map[referrerDay]int64{},
+        visitors:      map[time.Time]int64{},
+        seen:          map[string]bool{},
+    }
+}
+
+// The calendar day in the site timezone, as a date at UTC midnight
+func siteDay(t time.Time) time.Time {
+    y, m, d := t.In(config.Timezone()).Date()
+    return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Reduce a referrer to its host. Links from our own site count as direct.
+func referrerHost(referrer string) string {
+    parsed, err := url.Parse(referrer)
+    if err != nil || parsed.Host == "" {
+        return ""
+    }
+    host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+
+    for _, own := range []string{config.FrontendURL(), config.PublicBaseURL()} {
+        if ownURL, err := url.Parse(own); err == nil && strings.TrimPrefix(strings.ToLower(ownURL.Hostname()), "www.") == host {
+            return ""
+        }
+    }
+    return host
+}
+
+// RecordView counts a page view. Bots are dropped silently. Nothing that could
+// identify the reader is kept: the IP only feeds the daily visitor hash.
+func (s *AnalyticsService) RecordView(postID uint, ip, userAgent, referrer string) error {
+    if analytics.IsBot(userAgent) {
+        return nil
+    }
+
+    published, err := s.blogRepo.IsPublished(postID)
+    if err != nil {
+        return err
+    }
+    if !published {
+        return ErrPostNotFound
+    }
+
+    now := time.Now()
+    day := siteDay(now)
+    visitor := s.hasher.Hash(day.Format("2006-01-02"), ip, userAgent)
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if !day.Equal(s.seenDay) {
+        s.seenDay = day
+        s.seen = map[string]bool{}
+    }
+
+    key := postDay{postID: postID, day: day}
+    count, ok := s.posts[key]
+    if !ok {
+        count = &viewCount{}
+        s.posts[key] = count
+    }
+    count.views++
+
+    seenKey := fmt.Sprintf("%d:%s", postID, visitor)
+    if !s.seen[seenKey] {
+        s.seen[seenKey] = true
+        count.visitors++
+    }
+    if siteKey := "site:" + visitor; !s.seen[siteKey] {
+        s.seen[siteKey] = true
+        s.visitors[day]++
+    }
+
+    s.referrers[referrerDay{host: referrerHost(referrer), day: day}]++
+    return nil
+}
+
+// Run flushes the buffer every interval, forever. Start it in a goroutine.
+func (s *AnalyticsService) Run(interval time.Duration) {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+
+    for range ticker.C {
+        if err := s.Flush(); err != nil {
+            log.Printf("⚠️ Failed to flush page views: %v", err)
+        }
+    }
+}
+
+// Flush writes buffered counts to the daily counters. Counts that fail to
+// write go back into the buffer for the next attempt.
+func (s *AnalyticsService) Flush() error {
+    s.mu.Lock()
+    posts, referrers, visitors := s.posts, s.referrers, s.visitors
+    s.posts = map[postDay]*viewCount{}
+    s.referrers = map[referrerDay]int64{}
+    s.visitors = map[time.Time]int64{}
+    s.mu.Unlock()
+
+    postRows := make([]models.PostViewDaily, 0, len(posts))
+    for key, count := range posts {
+        postRows = append(postRows, models.PostViewDaily{BlogPostID: key.postID, Day: key.day, Views: count.views, Visitors: count.visitors})
+    }
+    referrerRows := make([]models.ReferrerDaily, 0, len(referrers))
+    for key, views := range referrers {
+        referrerRows = append(referrerRows, models.ReferrerDaily{Host: key.host, Day: key.day, Views: views})
+    }
+    visitorRows := make([]models.SiteVisitorDaily, 0, len(visitors))
+    for day, count := range visitors {
+        visitorRows = append(visitorRows, models.SiteVisitorDaily{Day: day, Visitors: count})
+    }
+
+    if err := s.analyticsRepo.AddPostViews(postRows); err != nil {
+        s.restore(posts, referrers, visitors)
+        return err
+    }
+    if err := s.analyticsRepo.AddReferrerViews(referrerRows); err != nil {
+        s.restore(nil, referrers, visitors)
+        return err
+    }
+    if err := s.analyticsRepo.AddSiteVisitors(visitorRows); err != nil {
+        s.restore(nil, nil, visitors)
+        return err
+    }
+    return nil
+}
+
+func (s *AnalyticsService) restore(posts map[postDay]*viewCount, referrers map[referrerDay]int64, visitors map[time.Time]int64) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    for key, count := range posts {
+        if existing, ok := s.posts[key]; ok {
+            existing.views += count.views
+            existing.visitors += count.visitors
+        } else {
+            s.posts[key] = count
+        }
+    }
+    for key, views := range referrers {
+        s.referrers[key] += views
+    }
+    for day, count := range visitors {
+        s.visitors[day] += count
+    }
+}
+
+// GetReport summarises the last days days, today included
+func (s *AnalyticsService) GetReport(days int) (*models.AnalyticsResponse, error) {
+    if days < 1 || days > analyticsMaxDays {
+        return nil, errors.New("days must be between 1 and 365")
+    }
+
+    // Counts still in the buffer should show up too
+    if err := s.Flush(); err != nil {
+        log.Printf("⚠️ Failed to flush page views: %v", err)
+    }
+
+    to := siteDay(time.Now())
+    from := to.AddDate(0, 0, -(days - 1))
+
+    report := &models.AnalyticsResponse{
+        From:      from.Format("2006-01-02"),
+        To:        to.Format("2006-01-02"),
+        TopPosts:  []models.PostStatsResponse{},
+        Referrers: []models.ReferrerResponse{},
+        Trend:     []models.DailyStatsResponse{},
+    }
+
+    totals, err := s.analyticsRepo.TopPosts(from, to, analyticsTopLimit)
+    if err != nil {
+        return nil, err
+    }
+    ids := make([]uint, 0, len(totals))
+    for _, total := range totals {
+        ids = append(ids, total.BlogPostID)
+    }
+    posts, err := s.blogRepo.GetByIDs(ids)
+    if err != nil {
+        return nil, err
+    }
+    titles := map[uint]string{}
+    for _, post := range posts {
+        titles[post.ID] = post.Title
+    }
+    for _, total := range totals {
+        report.TopPosts = append(report.TopPosts, models.PostStatsResponse{
+            PostID:   total.BlogPostID,
+            Title:    titles[total.BlogPostID], // Empty for posts deleted since
+            URL:      config.PostURL(total.BlogPostID),
+            Views:    total.Views,
+            Visitors: total.Visitors,
+        })
+    }
+
+    referrers, err := s.analyticsRepo.TopReferrers(from, to, analyticsTopLimit)
+    if err != nil {
+        return nil, err
+    }
+    report.Referrers = append(report.Referrers, referrers...)
+
+    daily, err := s.analyticsRepo.DailyTotals(from, to)
+    if err != nil {
+        return nil, err
+    }
+    byDay := map[string]models.DailyViewTotal{}
+    for _, total := range daily {
+        byDay[total.Day.Format("2006-01-02")] = total
+    }
+    for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+        total := byDay[day.Format("2006-01-02")]
+        report.Trend = append(report.Trend, models.DailyStatsResponse{
+            Day:      day.Format("2006-01-02"),
+            Views:    total.Views,
+            Visitors: total.Visitors,
+        })
+        report.Views += total.Views
+        report.Visitors += total.Visitors
+    }
+
+    return report, nil
+}
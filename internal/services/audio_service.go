@@ -0,0 +1,235 @@
+package services
+
+import (
+    "auth2_google/internal/audio"
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/storage"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "io"
+    "log"
+)
+
+type AudioServiceInterface interface {
+    AttachAudio(ctx context.Context, postID, userID uint, filename string, file io.ReadSeeker, size int64) (*models.AudioResponse, error)
+    DeleteAudio(ctx context.Context, postID, userID uint) error
+    RemoveAudio(ctx context.Context, postID uint) error
+    OpenAudio(ctx context.Context, postID uint) (io.ReadSeekCloser, *models.PostAudio, error)
+    UpdateEpisode(postID, userID uint, req models.UpdateEpisodeRequest) (*models.AudioResponse, error)
+    MaxAudioBytes() int64
+}
+
+type AudioService struct {
+    blogRepo      repositories.BlogRepositoryInterface
+    audioRepo     repositories.AudioRepositoryInterface
+    userRepo      repositories.UserRepositoryInterface
+    store         storage.Storage
+    maxAudioBytes int64
+}
+
+func NewAudioService(blogRepo repositories.BlogRepositoryInterface, audioRepo repositories.AudioRepositoryInterface, userRepo repositories.UserRepositoryInterface, store storage.Storage, maxAudioBytes int64) AudioServiceInterface {
+    return &AudioService{
+        blogRepo:      blogRepo,
+        audioRepo:     audioRepo,
+        userRepo:      userRepo,
+        store:         store,
+        maxAudioBytes: maxAudioBytes,
+    }
+}
+
+// Audio is always streamed through our own endpoint so range requests work on every storage backend
+func toAudioResponse(postAudio models.PostAudio) *models.AudioResponse {
+    return &models.AudioResponse{
+        URL:          fmt.Sprintf("%s/api/posts/%d/audio", config.PublicBaseURL(), postAudio.BlogPostID),
+        Duration:     postAudio.Duration,
+        DurationText: formatDuration(postAudio.Duration),
+        Bitrate:      postAudio.Bitrate,
+        ContentType:  postAudio.ContentType,
+        Size:         postAudio.Size,
+        Episode:      postAudio.Episode,
+        Season:       postAudio.Season,
+        Explicit:     postAudio.Explicit,
+        EpisodeType:  postAudio.EpisodeType,
+    }
+}
+
+// "4:05" or "1:02:03"
+func formatDuration(seconds float64) string {
+    total := int(seconds + 0.5)
+    hours, minutes, secs := total/3600, total%3600/60, total%60
+    if hours > 0 {
+        return fmt.Sprintf("%d:%02d:%02d", hours, minutes, secs)
+    }
+    return fmt.Sprintf("%d:%02d", minutes, secs)
+}
+
+func (s *AudioService) MaxAudioBytes() int64 {
+    return s.maxAudioBytes
+}
+
+func (s *AudioService) AttachAudio(ctx context.Context, postID, userID uint, filename string, file io.ReadSeeker, size int64) (*models.AudioResponse, error) {
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID); err != nil {
+        return nil, err
+    }
+    if size > s.maxAudioBytes {
+        return nil, ErrFileTooLarge
+    }
+
+    info, err := audio.Probe(file, size)
+    if err != nil {
+        return nil, ErrUnsupportedMediaType
+    }
+
+    // Content-hashed key, like images
+    hasher := sha256.New()
+    if _, err := file.Seek(0, io.SeekStart); err != nil {
+        return nil, err
+    }
+    if _, err := io.Copy(hasher, file); err != nil {
+        return nil, fmt.Errorf("failed to read audio: %v", err)
+    }
+    hash := hex.EncodeToString(hasher.Sum(nil))
+    key := fmt.Sprintf("audio/%s/%s%s", hash[:2], hash, info.Extension)
+
+    if _, err := file.Seek(0, io.SeekStart); err != nil {
+        return nil, err
+    }
+    if err := s.store.Put(ctx, key, file, size, info.ContentType); err != nil {
+        return nil, fmt.Errorf("failed to store audio: %v", err)
+    }
+
+    postAudio := &models.PostAudio{BlogPostID: postID, EpisodeType: "full"}
+    var oldKey string
+    if existing, err := s.audioRepo.GetByBlogPostID(postID); err == nil {
+        postAudio = existing
+        oldKey = existing.Key
+    } else {
+        // New episodes are numbered after the latest one, editors can change it later
+        episode, err := s.audioRepo.NextEpisodeNumber()
+        if err != nil {
+            return nil, err
+        }
+        postAudio.Episode = &episode
+    }
+
+    postAudio.UserID = userID
+    postAudio.Key = key
+    postAudio.ContentType = info.ContentType
+    postAudio.Size = size
+    postAudio.Duration = info.Duration
+    postAudio.Bitrate = info.Bitrate
+    postAudio.SampleRate = info.SampleRate
+    postAudio.Channels = info.Channels
+    postAudio.OriginalName = filename
+
+    if err := s.audioRepo.Save(postAudio); err != nil {
+        return nil, err
+    }
+
+    if oldKey != "" && oldKey != key {
+        s.deleteUnusedFile(ctx, oldKey)
+    }
+
+    return toAudioResponse(*postAudio), nil
+}
+
+// DeleteAudio removes the post's audio on behalf of one of its authors or an editor
+func (s *AudioService) DeleteAudio(ctx context.Context, postID, userID uint) error {
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID); err != nil {
+        return err
+    }
+    return s.RemoveAudio(ctx, postID)
+}
+
+func (s *AudioService) RemoveAudio(ctx context.Context, postID uint) error {
+    postAudio, err := s.audioRepo.GetByBlogPostID(postID)
+    if err != nil {
+        return errors.New("audio not found")
+    }
+
+    if err := s.audioRepo.Delete(postAudio.ID); err != nil {
+        return err
+    }
+    if err := s.blogRepo.Touch(postID); err != nil {
+        log.Printf("⚠️ Failed to mark post %d as changed: %v", postID, err)
+    }
+    s.deleteUnusedFile(ctx, postAudio.Key)
+    return nil
+}
+
+// Identical files share a key, so only delete once no post uses it anymore
+func (s *AudioService) deleteUnusedFile(ctx context.Context, key string) {
+    count, err := s.audioRepo.CountByKey(key)
+    if err != nil || count > 0 {
+        return
+    }
+    if err := s.store.Delete(ctx, key); err != nil {
+        log.Printf("⚠️ Failed to delete audio %s: %v", key, err)
+    }
+}
+
+// OpenAudio streams a published post's audio, drafts and trashed posts have none as far as readers know
+func (s *AudioService) OpenAudio(ctx context.Context, postID uint) (io.ReadSeekCloser, *models.PostAudio, error) {
+    published, err := s.blogRepo.IsPublished(postID)
+    if err != nil {
+        return nil, nil, err
+    }
+    if !published {
+        return nil, nil, ErrPostNotFound
+    }
+
+    postAudio, err := s.audioRepo.GetByBlogPostID(postID)
+    if err != nil {
+        return nil, nil, errors.New("audio not found")
+    }
+
+    file, err := s.store.Open(ctx, postAudio.Key)
+    if err != nil {
+        return nil, nil, fmt.Errorf("failed to open audio: %v", err)
+    }
+    return file, postAudio, nil
+}
+
+var episodeTypes = map[string]bool{"full": true, "trailer": true, "bonus": true}
+
+func (s *AudioService) UpdateEpisode(postID, userID uint, req models.UpdateEpisodeRequest) (*models.AudioResponse, error) {
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID); err != nil {
+        return nil, err
+    }
+    postAudio, err := s.audioRepo.GetByBlogPostID(postID)
+    if err != nil {
+        return nil, errors.New("audio not found")
+    }
+
+    if req.Episode != nil {
+        if *req.Episode < 1 {
+            return nil, errors.New("episode must be a positive number")
+        }
+        postAudio.Episode = req.Episode
+    }
+    if req.Season != nil {
+        if *req.Season < 1 {
+            return nil, errors.New("season must be a positive number")
+        }
+        postAudio.Season = req.Season
+    }
+    if req.Explicit != nil {
+        postAudio.Explicit = *req.Explicit
+    }
+    if req.EpisodeType != nil {
+        if !episodeTypes[*req.EpisodeType] {
+            return nil, errors.New("episode type must be full, trailer or bonus")
+        }
+        postAudio.EpisodeType = *req.EpisodeType
+    }
+
+    if err := s.audioRepo.Save(postAudio); err != nil {
+        return nil, err
+    }
+    return toAudioResponse(*postAudio), nil
+}
@@ -1,98 +1,444 @@
 package services
 
 import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/i18n"
     "auth2_google/internal/models"
     "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+    "auth2_google/internal/utils"
     "errors"
     "fmt"
+    "log"
     "time"
 )
 
+var ErrPostNotFound = errors.New("post not found")
+
 type BlogServiceInterface interface {
-    CreatePost(req models.CreateBlogPostRequest) (*models.BlogPostResponse, error)
-    GetAllPosts() ([]models.BlogPostResponse, error)
-    GetPostByID(id uint) (*models.BlogPostResponse, error)
-    UpdatePost(id uint, req models.UpdateBlogPostRequest) (*models.BlogPostResponse, error)
-    DeletePost(id uint) error
-    GetPublishedPosts() ([]models.BlogPostResponse, error) // Keep existing
+    CreatePost(req models.CreateBlogPostRequest, authorID uint) (*models.BlogPostResponse, error)
+    GetAllPosts(opts models.ReadOptions) ([]models.BlogPostResponse, error)
+    GetPostByID(id uint, opts models.ReadOptions) (*models.BlogPostResponse, error)
+    GetDraft(id uint, opts models.ReadOptions) (*models.BlogPostResponse, error)
+    UpdatePost(id, userID uint, req models.UpdateBlogPostRequest) (*models.BlogPostResponse, error)
+    DeletePost(id, userID uint, commentAction string) error
+    GetPublishedPosts(opts models.ReadOptions) ([]models.BlogPostResponse, error) // Keep existing
+    SearchPosts(query string, limit int, opts models.ReadOptions) ([]models.BlogPostResponse, error)
+    GetPublishedPostsByIDs(ids []uint, opts models.ReadOptions) ([]models.BlogPostResponse, error)
+    BulkUpdate(req models.BulkPostRequest) (*models.BulkPostResponse, error)
 }
 
 type BlogService struct {
-    blogRepo repositories.BlogRepositoryInterface
+    blogRepo     repositories.BlogRepositoryInterface
+    userRepo     repositories.UserRepositoryInterface
+    mediaRepo    repositories.MediaRepositoryInterface
+    tagRepo      repositories.TagRepositoryInterface
+    reactionRepo repositories.ReactionRepositoryInterface
+    bookmarkRepo repositories.BookmarkRepositoryInterface
+    seriesRepo   repositories.SeriesRepositoryInterface
+    searchIndex  search.SearchIndex
+    related      *search.RelatedIndex
 }
 
-func NewBlogService(blogRepo repositories.BlogRepositoryInterface) BlogServiceInterface {
+func NewBlogService(blogRepo repositories.BlogRepositoryInterface, userRepo repositories.UserRepositoryInterface, mediaRepo repositories.MediaRepositoryInterface, tagRepo repositories.TagRepositoryInterface, reactionRepo repositories.ReactionRepositoryInterface, bookmarkRepo repositories.BookmarkRepositoryInterface, seriesRepo repositories.SeriesRepositoryInterface, searchIndex search.SearchIndex, related *search.RelatedIndex) BlogServiceInterface {
     return &BlogService{
-        blogRepo: blogRepo,
+        blogRepo:     blogRepo,
+        userRepo:     userRepo,
+        mediaRepo:    mediaRepo,
+        tagRepo:      tagRepo,
+        reactionRepo: reactionRepo,
+        bookmarkRepo: bookmarkRepo,
+        seriesRepo:   seriesRepo,
+        searchIndex:  searchIndex,
+        related:      related,
     }
 }
 
-// 🔥 Helper function to format date
-func formatDate(t time.Time) string {
-    return t.Format("January 2, 2006") // "June 20, 2025"
+// date has always been this English format, clients depend on it
+const legacyPostDate = "January 2, 2006"
+
+// How many times the requested number of hits to ask the search index for,
+// so drafts it ranks high don't leave readers short of results
+const searchOverfetch = 4
+
+// 🔥 Helper function to format date in the site timezone
+func formatDate(t time.Time, locale string) string {
+    return i18n.FormatDate(t.In(config.Timezone()), locale) // "June 20, 2025", "২০ জুন ২০২৫"
+}
+
+// ISO-8601 with the site timezone's offset
+func isoTime(t time.Time) string {
+    return t.In(config.Timezone()).Format(time.RFC3339)
+}
+
+func relativeDate(t time.Time, locale string) string {
+    return i18n.RelativeTime(t, time.Now(), locale)
+}
+
+// Responses to writes aren't tied to a reader's preferences
+func defaultReadOptions() models.ReadOptions {
+    return models.ReadOptions{Locale: config.DateLocale()}
 }
 
-// 🔥 Convert to response format
-func (s *BlogService) toResponse(post models.BlogPost) models.BlogPostResponse {
+// 🔥 Convert to response format, in opts.Language when the post has a published
+// translation. An empty language gives the canonical text.
+func (s *BlogService) toResponse(post models.BlogPost, opts models.ReadOptions) models.BlogPostResponse {
+    post, languages := localize(post, opts.Language)
+
+    var imageDetails *models.ImageResponse
+    if post.ImageMedia != nil {
+        imageDetails = toImageResponse(*post.ImageMedia)
+    }
+
+    var audio *models.AudioResponse
+    if post.Audio != nil {
+        audio = toAudioResponse(*post.Audio)
+    }
+
     return models.BlogPostResponse{
-        ID:      fmt.Sprintf("%d", post.ID), // Convert to string
-        Title:   post.Title,
-        Excerpt: post.Excerpt, // 🔥 NEW
-        Author:  post.Author,  // 🔥 NEW
-        Date:    formatDate(post.CreatedAt),
-        Image:   post.Image,   // 🔥 NEW
+        ID:        fmt.Sprintf("%d", post.ID), // Convert to string
+        Title:     post.Title,
+        Excerpt:   post.Excerpt, // 🔥 NEW
+        Author:    post.Author,  // 🔥 NEW
+        Date:      post.CreatedAt.Format(legacyPostDate),
+        Image:     post.Image,   // 🔥 NEW
+        Published: post.Published,
+        Language:  post.Language,
+
+        WordCount:       post.WordCount,
+        ReadingMinutes:  post.ReadingMinutes,
+        ReadingTimeText: i18n.ReadingTime(post.ReadingMinutes, opts.Locale),
+
+        LocalizedDate: formatDate(post.CreatedAt, opts.Locale),
+        RelativeDate:  relativeDate(post.CreatedAt, opts.Locale),
+        CreatedAt:     isoTime(post.CreatedAt),
+        UpdatedAt:     isoTime(post.UpdatedAt),
+        PublishedAt:   publishedAtISO(post),
+
+        Languages:    languages,
+        Tags:         toTagResponses(post.Tags),
+        Authors:      toAuthorResponses(post.Authors),
+        ImageDetails: imageDetails,
+        Audio:        audio,
+    }
+}
+
+// Point the post's image at an uploaded media item
+func (s *BlogService) setImageMedia(post *models.BlogPost, mediaID uint) error {
+    media, err := s.mediaRepo.GetByID(mediaID)
+    if err != nil {
+        return errors.New("image not found")
+    }
+    post.ImageID = &media.ID
+    post.Image = media.URL
+    return nil
+}
+
+func toTagResponses(tags []models.Tag) []models.TagResponse {
+    responses := []models.TagResponse{}
+    for _, tag := range tags {
+        responses = append(responses, models.TagResponse{Name: tag.Name, Slug: tag.Slug})
     }
+    return responses
 }
 
-func (s *BlogService) CreatePost(req models.CreateBlogPostRequest) (*models.BlogPostResponse, error) {
-    if req.Title == "" || req.Excerpt == "" || req.Author == "" {
-        return nil, errors.New("title, excerpt and author are required")
+// List responses, with reaction counts and saved flags for all posts fetched in one query each
+func (s *BlogService) toResponses(posts []models.BlogPost, opts models.ReadOptions) []models.BlogPostResponse {
+    ids := make([]uint, len(posts))
+    for i, post := range posts {
+        ids[i] = post.ID
+    }
+    reactions := countReactions(s.reactionRepo, ids)
+    saved := s.savedPosts(opts.UserID, ids)
+
+    responses := make([]models.BlogPostResponse, 0, len(posts))
+    for _, post := range posts {
+        response := s.toResponse(post, opts)
+        response.Reactions = reactionCounts(reactions[post.ID])
+        if saved != nil {
+            isSaved := saved[post.ID]
+            response.Saved = &isSaved
+        }
+        responses = append(responses, response)
+    }
+    return responses
+}
+
+// Which of the posts the reader bookmarked, nil for anonymous readers.
+// Like reaction counts, a failure here shouldn't stop the posts loading.
+func (s *BlogService) savedPosts(userID *uint, postIDs []uint) map[uint]bool {
+    if userID == nil {
+        return nil
+    }
+
+    ids, err := s.bookmarkRepo.SavedAmong(*userID, postIDs)
+    if err != nil {
+        log.Printf("⚠️ Failed to load bookmarks for user %d: %v", *userID, err)
+        return nil
+    }
+
+    saved := map[uint]bool{}
+    for _, id := range ids {
+        saved[id] = true
+    }
+    return saved
+}
+
+// Single post responses also carry the full body and series navigation
+func (s *BlogService) toDetailResponse(post models.BlogPost, opts models.ReadOptions) *models.BlogPostResponse {
+    response := s.toResponses([]models.BlogPost{post}, opts)[0]
+    localized, _ := localize(post, opts.Language)
+    response.Content = localized.Content
+    response.Series = s.postSeries(post.ID, opts)
+    return &response
+}
+
+// Navigation within the post's series, nil when it isn't part of one.
+// A failed lookup only costs the links, not the post.
+func (s *BlogService) postSeries(postID uint, opts models.ReadOptions) *models.SeriesNavigation {
+    series, err := s.seriesRepo.GetByPostID(postID)
+    if err != nil {
+        log.Printf("⚠️ Failed to load series for post %d: %v", postID, err)
+        return nil
+    }
+    if series == nil {
+        return nil
+    }
+    return seriesNavigation(*series, postID, opts.Language)
+}
+
+// localize swaps in the text of a published translation. Without one the
+// canonical text stays, so readers always get something. Also returns every
+// language the post can be read in, canonical first.
+func localize(post models.BlogPost, lang string) (models.BlogPost, []string) {
+    languages := []string{post.Language}
+    var match *models.PostTranslation
+    for i, translation := range post.Translations {
+        if !translation.Published {
+            continue
+        }
+        languages = append(languages, translation.Language)
+        if translation.Language == lang {
+            match = &post.Translations[i]
+        }
+    }
+
+    if match != nil {
+        post.Title = match.Title
+        post.Excerpt = match.Excerpt
+        post.Content = match.Content
+        post.Language = match.Language
+        post.WordCount = match.WordCount
+        post.ReadingMinutes = match.ReadingMinutes
+    }
+    return post, languages
+}
+
+func publishedAtISO(post models.BlogPost) string {
+    if post.PublishedAt == nil {
+        return ""
+    }
+    return isoTime(*post.PublishedAt)
+}
+
+// Record when a post first goes live, unpublishing keeps the original date
+func setPublished(post *models.BlogPost, published bool) {
+    post.Published = published
+    if published && post.PublishedAt == nil {
+        now := time.Now()
+        post.PublishedAt = &now
+    }
+}
+
+func authorResponse(user models.User) models.AuthorResponse {
+    return models.AuthorResponse{
+        ID:         user.ID,
+        Name:       user.Name,
+        Avatar:     user.Picture,
+        ProfileURL: fmt.Sprintf("%s/authors/%d", config.FrontendURL(), user.ID),
+    }
+}
+
+func toAuthorResponses(authors []models.PostAuthor) []models.AuthorResponse {
+    responses := []models.AuthorResponse{}
+    for _, author := range authors {
+        user := author.User
+        user.ID = author.UserID
+        responses = append(responses, authorResponse(user))
+    }
+    return responses
+}
+
+// Build the ordered author list, primary author first. Duplicates are dropped.
+func (s *BlogService) buildAuthors(primaryID *uint, coAuthorIDs []uint) ([]models.PostAuthor, error) {
+    var ids []uint
+    seen := map[uint]bool{}
+    if primaryID != nil {
+        ids = append(ids, *primaryID)
+        seen[*primaryID] = true
+    }
+    for _, id := range coAuthorIDs {
+        if !seen[id] {
+            ids = append(ids, id)
+            seen[id] = true
+        }
+    }
+
+    users, err := s.userRepo.GetByIDs(ids)
+    if err != nil {
+        return nil, err
+    }
+    if len(users) != len(ids) {
+        return nil, errors.New("one or more co-authors do not exist")
+    }
+
+    authors := make([]models.PostAuthor, 0, len(ids))
+    for i, id := range ids {
+        authors = append(authors, models.PostAuthor{UserID: id, Position: i})
+    }
+    return authors, nil
+}
+
+func (s *BlogService) CreatePost(req models.CreateBlogPostRequest, authorID uint) (*models.BlogPostResponse, error) {
+    if req.Title == "" || req.Excerpt == "" {
+        return nil, errors.New("title and excerpt are required")
+    }
+
+    author, err := s.userRepo.GetByID(authorID)
+    if err != nil {
+        return nil, errors.New("author not found")
+    }
+
+    authors, err := s.buildAuthors(&author.ID, req.CoAuthorIDs)
+    if err != nil {
+        return nil, err
+    }
+
+    language := req.Language
+    if language == "" {
+        language = config.DefaultLanguage()
+    }
+    if !config.IsSupportedLanguage(language) {
+        return nil, ErrUnsupportedLanguage
     }
 
     post := &models.BlogPost{
-        Title:   req.Title,
-        Excerpt: req.Excerpt, // 🔥 NEW
-        Author:  req.Author,  // 🔥 NEW
-        Image:   req.Image,   // 🔥 NEW
+        Title:    req.Title,
+        Excerpt:  req.Excerpt, // 🔥 NEW
+        AuthorID: &author.ID,
+        Author:   author.Name,
+        Image:    req.Image,   // 🔥 NEW
+        Content:  req.Content,
+        Language: language,
+        Authors:  authors,
+
+        ShareTitle:       req.ShareTitle,
+        ShareDescription: req.ShareDescription,
+        ShareImage:       req.ShareImage,
+    }
+    // Guest contributors' posts go through editorial review
+    if !isStaff(author) {
+        if req.Published {
+            return nil, ErrNeedsApproval
+        }
+        post.ReviewStatus = models.ReviewDraft
+    }
+    post.WordCount, post.ReadingMinutes = utils.ReadingStats(post.Content)
+    setPublished(post, req.Published)
+    if req.ImageID != nil {
+        if err := s.setImageMedia(post, *req.ImageID); err != nil {
+            return nil, err
+        }
+    }
+
+    if post.Tags, err = s.tagRepo.FindOrCreate(req.Tags); err != nil {
+        return nil, err
     }
 
-    err := s.blogRepo.Create(post)
+    err = s.blogRepo.Create(post)
     if err != nil {
         return nil, err
     }
 
-    response := s.toResponse(*post)
-    return &response, nil
+    // Reload so the author accounts are included
+    created, err := s.blogRepo.GetByID(post.ID)
+    if err != nil {
+        return nil, err
+    }
+    s.indexPost(*created)
+
+    return s.toDetailResponse(*created, defaultReadOptions()), nil
+}
+
+// reader loads the signed-in reader, nil for anonymous requests
+func (s *BlogService) reader(opts models.ReadOptions) *models.User {
+    return loadReader(s.userRepo, opts.UserID)
+}
+
+func loadReader(userRepo repositories.UserRepositoryInterface, userID *uint) *models.User {
+    if userID == nil {
+        return nil
+    }
+    user, err := userRepo.GetByID(*userID)
+    if err != nil {
+        return nil
+    }
+    return user
+}
+
+// Drafts are only shown to their authors and editors
+func canRead(post *models.BlogPost, reader *models.User) bool {
+    if post.Published {
+        return true
+    }
+    return reader != nil && (isStaff(reader) || isPostAuthor(post, reader.ID))
+}
+
+// readable drops the posts the reader isn't allowed to see
+func readable(posts []models.BlogPost, reader *models.User) []models.BlogPost {
+    visible := []models.BlogPost{}
+    for i := range posts {
+        if canRead(&posts[i], reader) {
+            visible = append(visible, posts[i])
+        }
+    }
+    return visible
 }
 
-func (s *BlogService) GetAllPosts() ([]models.BlogPostResponse, error) {
+func (s *BlogService) GetAllPosts(opts models.ReadOptions) ([]models.BlogPostResponse, error) {
     posts, err := s.blogRepo.GetAll()
     if err != nil {
         return nil, err
     }
 
-    var responses []models.BlogPostResponse
-    for _, post := range posts {
-        responses = append(responses, s.toResponse(post))
+    return s.toResponses(readable(posts, s.reader(opts)), opts), nil
+}
+
+// GetPostByID returns the post if the reader may see it, drafts look missing to everyone else
+func (s *BlogService) GetPostByID(id uint, opts models.ReadOptions) (*models.BlogPostResponse, error) {
+    post, err := s.blogRepo.GetByID(id)
+    if err != nil || !canRead(post, s.reader(opts)) {
+        return nil, ErrPostNotFound
     }
 
-    return responses, nil
+    return s.toDetailResponse(*post, opts), nil
 }
 
-func (s *BlogService) GetPostByID(id uint) (*models.BlogPostResponse, error) {
+// GetDraft returns the post whether published or not, for signed preview links
+func (s *BlogService) GetDraft(id uint, opts models.ReadOptions) (*models.BlogPostResponse, error) {
     post, err := s.blogRepo.GetByID(id)
     if err != nil {
-        return nil, errors.New("post not found")
+        return nil, ErrPostNotFound
     }
 
-    response := s.toResponse(*post)
-    return &response, nil
+    return s.toDetailResponse(*post, opts), nil
 }
 
-func (s *BlogService) UpdatePost(id uint, req models.UpdateBlogPostRequest) (*models.BlogPostResponse, error) {
-    post, err := s.blogRepo.GetByID(id)
+// UpdatePost changes a post on behalf of one of its authors or an editor
+func (s *BlogService) UpdatePost(id, userID uint, req models.UpdateBlogPostRequest) (*models.BlogPostResponse, error) {
+    post, user, err := editablePost(s.blogRepo, s.userRepo, id, userID)
     if err != nil {
-        return nil, errors.New("post not found")
+        return nil, err
     }
 
     if req.Title != nil {
@@ -101,41 +447,359 @@ func (s *BlogService) UpdatePost(id uint, req models.UpdateBlogPostRequest) (*mo
     if req.Excerpt != nil {
         post.Excerpt = *req.Excerpt // 🔥 NEW
     }
-    if req.Author != nil {
-        post.Author = *req.Author // 🔥 NEW
-    }
     if req.Image != nil {
         post.Image = *req.Image // 🔥 NEW
+        post.ImageID = nil      // A plain URL replaces any uploaded image
+    }
+    if req.ImageID != nil {
+        if err := s.setImageMedia(post, *req.ImageID); err != nil {
+            return nil, err
+        }
+    }
+    if req.Content != nil {
+        post.Content = *req.Content
+        post.WordCount, post.ReadingMinutes = utils.ReadingStats(post.Content)
+    }
+    // A guest's changes to reviewed text go back through review, offline meanwhile
+    textChanged := req.Title != nil || req.Excerpt != nil || req.Content != nil
+    if textChanged && !isStaff(user) && (post.ReviewStatus == models.ReviewApproved || post.Published) {
+        post.ReviewStatus = models.ReviewDraft
+        setPublished(post, false)
+    }
+    if req.Published != nil {
+        if *req.Published && !post.Published && !mayPublish(post, user) {
+            return nil, ErrNeedsApproval
+        }
+        setPublished(post, *req.Published)
+    }
+    if req.Language != nil {
+        if !config.IsSupportedLanguage(*req.Language) {
+            return nil, ErrUnsupportedLanguage
+        }
+        for _, translation := range post.Translations {
+            if translation.Language == *req.Language {
+                return nil, errors.New("post already has a translation in that language")
+            }
+        }
+        post.Language = *req.Language
+    }
+    if req.ShareTitle != nil {
+        post.ShareTitle = *req.ShareTitle
+    }
+    if req.ShareDescription != nil {
+        post.ShareDescription = *req.ShareDescription
+    }
+    if req.ShareImage != nil {
+        post.ShareImage = *req.ShareImage
+    }
+
+    var authors []models.PostAuthor
+    if req.CoAuthorIDs != nil {
+        if authors, err = s.buildAuthors(post.AuthorID, *req.CoAuthorIDs); err != nil {
+            return nil, err
+        }
+    }
+    var tags []models.Tag
+    if req.Tags != nil {
+        if tags, err = s.tagRepo.FindOrCreate(*req.Tags); err != nil {
+            return nil, err
+        }
     }
 
-    err = s.blogRepo.Update(post)
+    // The post, its authors and its tags change together or not at all
+    err = s.blogRepo.Transaction(func(repo repositories.BlogRepositoryInterface) error {
+        if err := repo.Update(post); err != nil {
+            return err
+        }
+        if req.CoAuthorIDs != nil {
+            if err := repo.ReplaceAuthors(post.ID, authors); err != nil {
+                return err
+            }
+        }
+        if req.Tags != nil {
+            return repo.ReplaceTags(post, tags)
+        }
+        return nil
+    })
     if err != nil {
         return nil, err
     }
 
-    response := s.toResponse(*post)
-    return &response, nil
+    // Reload to pick up changed authors and tags
+    if post, err = s.blogRepo.GetByID(id); err != nil {
+        return nil, err
+    }
+    s.indexPost(*post)
+
+    return s.toDetailResponse(*post, defaultReadOptions()), nil
 }
 
-func (s *BlogService) DeletePost(id uint) error {
-    _, err := s.blogRepo.GetByID(id)
-    if err != nil {
-        return errors.New("post not found")
+// DeletePost moves the post to the trash. Its comments go with it, or are
+// archived when commentAction is models.CommentsArchive.
+func (s *BlogService) DeletePost(id, userID uint, commentAction string) error {
+    if commentAction != models.CommentsDelete && commentAction != models.CommentsArchive {
+        return errors.New("comments must be delete or archive")
+    }
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, id, userID); err != nil {
+        return err
+    }
+
+    if err := s.blogRepo.Delete(id, commentAction == models.CommentsArchive); err != nil {
+        return err
     }
 
-    return s.blogRepo.Delete(id)
+    if err := s.searchIndex.Remove(id); err != nil {
+        log.Printf("⚠️ Failed to remove post %d from search index: %v", id, err)
+    }
+    s.related.Invalidate()
+    return nil
 }
 
-func (s *BlogService) GetPublishedPosts() ([]models.BlogPostResponse, error) {
+func (s *BlogService) GetPublishedPosts(opts models.ReadOptions) ([]models.BlogPostResponse, error) {
     posts, err := s.blogRepo.GetPublished()
     if err != nil {
         return nil, err
     }
 
-    var responses []models.BlogPostResponse
+    return s.toResponses(posts, opts), nil
+}
+
+func (s *BlogService) SearchPosts(query string, limit int, opts models.ReadOptions) ([]models.BlogPostResponse, error) {
+    reader := s.reader(opts)
+
+    // The index knows nothing of drafts, so ask it for more than limit and
+    // widen the window until enough readable posts turn up or it runs dry
+    fetch := limit * searchOverfetch
+    for {
+        ids, err := s.searchIndex.Search(query, fetch)
+        if err != nil {
+            return nil, err
+        }
+
+        posts, err := s.blogRepo.GetByIDs(ids)
+        if err != nil {
+            return nil, err
+        }
+
+        // Keep the ranking order from the index
+        byID := map[uint]models.BlogPost{}
+        for _, post := range posts {
+            byID[post.ID] = post
+        }
+
+        ranked := []models.BlogPost{}
+        for _, id := range ids {
+            if post, ok := byID[id]; ok {
+                ranked = append(ranked, post)
+            }
+        }
+
+        visible := readable(ranked, reader)
+        if len(visible) >= limit || len(ids) < fetch {
+            if len(visible) > limit {
+                visible = visible[:limit]
+            }
+            return s.toResponses(visible, opts), nil
+        }
+        fetch *= searchOverfetch
+    }
+}
+
+// GetPublishedPostsByIDs returns the published posts among ids, in the order given
+func (s *BlogService) GetPublishedPostsByIDs(ids []uint, opts models.ReadOptions) ([]models.BlogPostResponse, error) {
+    posts, err := s.blogRepo.GetByIDs(ids)
+    if err != nil {
+        return nil, err
+    }
+
+    byID := map[uint]models.BlogPost{}
     for _, post := range posts {
-        responses = append(responses, s.toResponse(post))
+        if post.Published {
+            byID[post.ID] = post
+        }
+    }
+
+    ordered := []models.BlogPost{}
+    for _, id := range ids {
+        if post, ok := byID[id]; ok {
+            ordered = append(ordered, post)
+        }
+    }
+
+    return s.toResponses(ordered, opts), nil
+}
+
+// Search index failures shouldn't fail the write, the reindex command can repair them.
+// Related posts are recomputed on the next lookup.
+func (s *BlogService) indexPost(post models.BlogPost) {
+    if err := s.searchIndex.Index(post); err != nil {
+        log.Printf("⚠️ Failed to index post %d: %v", post.ID, err)
+    }
+    s.related.Invalidate()
+}
+
+// Most posts one bulk request may touch
+const bulkMaxPosts = 500
+
+// BulkUpdate applies one action to many posts in a single transaction. Every post
+// is checked first; if any can't be changed, none are and the report says why.
+func (s *BlogService) BulkUpdate(req models.BulkPostRequest) (*models.BulkPostResponse, error) {
+    switch req.Action {
+    case models.BulkPublish, models.BulkUnpublish, models.BulkDelete:
+    case models.BulkTag, models.BulkRetag:
+        if req.Action == models.BulkTag && len(req.Tags) == 0 {
+            return nil, errors.New("tags are required")
+        }
+    case models.BulkChangeAuthor:
+        if req.AuthorID == nil {
+            return nil, errors.New("author_id is required")
+        }
+    default:
+        return nil, errors.New("action must be publish, unpublish, tag, retag, delete or change_author")
+    }
+    if req.Comments == "" {
+        req.Comments = models.CommentsDelete
+    }
+    if req.Action == models.BulkDelete && req.Comments != models.CommentsDelete && req.Comments != models.CommentsArchive {
+        return nil, errors.New("comments must be delete or archive")
+    }
+
+    ids := []uint{}
+    seen := map[uint]bool{}
+    for _, id := range req.IDs {
+        if !seen[id] {
+            ids = append(ids, id)
+            seen[id] = true
+        }
+    }
+    if len(ids) == 0 || len(ids) > bulkMaxPosts {
+        return nil, fmt.Errorf("ids must list between 1 and %d posts", bulkMaxPosts)
     }
 
-    return responses, nil
-}
\ No newline at end of file
+    var author *models.User
+    if req.Action == models.BulkChangeAuthor {
+        user, err := s.userRepo.GetByID(*req.AuthorID)
+        if err != nil {
+            return nil, errors.New("author not found")
+        }
+        author = user
+    }
+
+    posts, err := s.blogRepo.GetByIDs(ids)
+    if err != nil {
+        return nil, err
+    }
+    byID := map[uint]*models.BlogPost{}
+    for i := range posts {
+        byID[posts[i].ID] = &posts[i]
+    }
+
+    report := &models.BulkPostResponse{Action: req.Action, Results: make([]models.BulkItemResult, len(ids))}
+    failures := map[int]error{}
+    for i, id := range ids {
+        report.Results[i] = models.BulkItemResult{ID: id, Success: true}
+        if byID[id] == nil {
+            failures[i] = ErrPostNotFound
+        }
+    }
+    if len(failures) > 0 {
+        return failedBulkReport(report, failures), nil
+    }
+
+    var tags []models.Tag
+    if req.Action == models.BulkTag || req.Action == models.BulkRetag {
+        if tags, err = s.tagRepo.FindOrCreate(req.Tags); err != nil {
+            return nil, err
+        }
+    }
+
+    var failedAt int
+    err = s.blogRepo.Transaction(func(repo repositories.BlogRepositoryInterface) error {
+        for i, id := range ids {
+            failedAt = i
+            if err := s.applyBulkAction(repo, byID[id], req, tags, author); err != nil {
+                return err
+            }
+        }
+        return nil
+    })
+    if err != nil {
+        // The transaction rolled back, so nothing before the failure stuck either
+        return failedBulkReport(report, map[int]error{failedAt: err}), nil
+    }
+    report.Applied = true
+
+    // The search index and related posts live outside the transaction
+    for _, id := range ids {
+        if req.Action == models.BulkDelete {
+            if err := s.searchIndex.Remove(id); err != nil {
+                log.Printf("⚠️ Failed to remove post %d from search index: %v", id, err)
+            }
+        } else if post, err := s.blogRepo.GetByID(id); err == nil {
+            s.indexPost(*post)
+        }
+    }
+    s.related.Invalidate()
+
+    return report, nil
+}
+
+// Mark every post as unchanged, with the reason for the ones that failed
+func failedBulkReport(report *models.BulkPostResponse, failures map[int]error) *models.BulkPostResponse {
+    for i := range report.Results {
+        report.Results[i].Success = false
+        report.Results[i].Error = "not applied, another post failed"
+        if err, ok := failures[i]; ok {
+            report.Results[i].Error = err.Error()
+        }
+    }
+    return report
+}
+
+func (s *BlogService) applyBulkAction(repo repositories.BlogRepositoryInterface, post *models.BlogPost, req models.BulkPostRequest, tags []models.Tag, author *models.User) error {
+    switch req.Action {
+    case models.BulkPublish, models.BulkUnpublish:
+        setPublished(post, req.Action == models.BulkPublish)
+        return repo.Update(post)
+
+    case models.BulkTag:
+        has := map[uint]bool{}
+        for _, tag := range post.Tags {
+            has[tag.ID] = true
+        }
+        merged := append([]models.Tag{}, post.Tags...)
+        for _, tag := range tags {
+            if !has[tag.ID] {
+                merged = append(merged, tag)
+            }
+        }
+        return repo.ReplaceTags(post, merged)
+
+    case models.BulkRetag:
+        return repo.ReplaceTags(post, tags)
+
+    case models.BulkDelete:
+        return repo.Delete(post.ID, req.Comments == models.CommentsArchive)
+
+    case models.BulkChangeAuthor:
+        // The old primary author is dropped, co-authors stay in their order
+        coAuthorIDs := []uint{}
+        for _, existing := range post.Authors {
+            if existing.Position > 0 {
+                coAuthorIDs = append(coAuthorIDs, existing.UserID)
+            }
+        }
+        authors, err := s.buildAuthors(&author.ID, coAuthorIDs)
+        if err != nil {
+            return err
+        }
+
+        post.AuthorID = &author.ID
+        post.Author = author.Name
+        if err := repo.Update(post); err != nil {
+            return err
+        }
+        return repo.ReplaceAuthors(post.ID, authors)
+    }
+    return nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "errors"
+    "strings"
+)
+
+var ErrReadingListNotFound = errors.New("reading list not found")
+
+type BookmarkServiceInterface interface {
+    GetBookmarks(userID uint, opts models.ReadOptions) ([]models.BlogPostResponse, error)
+    SaveBookmark(userID, postID uint) error
+    RemoveBookmark(userID, postID uint) error
+
+    GetReadingLists(userID uint) ([]models.ReadingListResponse, error)
+    GetReadingList(userID, listID uint, opts models.ReadOptions) (*models.ReadingListResponse, error)
+    CreateReadingList(userID uint, req models.SaveReadingListRequest) (*models.ReadingListResponse, error)
+    RenameReadingList(userID, listID uint, req models.SaveReadingListRequest) (*models.ReadingListResponse, error)
+    DeleteReadingList(userID, listID uint) error
+    AddToReadingList(userID, listID, postID uint) error
+    RemoveFromReadingList(userID, listID, postID uint) error
+    ReorderReadingList(userID, listID uint, req models.ReorderReadingListRequest) error
+}
+
+type BookmarkService struct {
+    blogRepo        repositories.BlogRepositoryInterface
+    bookmarkRepo    repositories.BookmarkRepositoryInterface
+    readingListRepo repositories.ReadingListRepositoryInterface
+    blogService     BlogServiceInterface
+}
+
+func NewBookmarkService(blogRepo repositories.BlogRepositoryInterface, bookmarkRepo repositories.BookmarkRepositoryInterface, readingListRepo repositories.ReadingListRepositoryInterface, blogService BlogServiceInterface) BookmarkServiceInterface {
+    return &BookmarkService{
+        blogRepo:        blogRepo,
+        bookmarkRepo:    bookmarkRepo,
+        readingListRepo: readingListRepo,
+        blogService:     blogService,
+    }
+}
+
+func toReadingListResponse(list models.ReadingList) *models.ReadingListResponse {
+    return &models.ReadingListResponse{
+        ID:        list.ID,
+        Name:      list.Name,
+        PostCount: len(list.Items),
+        CreatedAt: isoTime(list.CreatedAt),
+        UpdatedAt: isoTime(list.UpdatedAt),
+    }
+}
+
+// GetBookmarks returns the user's saved posts, most recently saved first.
+// Posts unpublished since they were saved are left out.
+func (s *BookmarkService) GetBookmarks(userID uint, opts models.ReadOptions) ([]models.BlogPostResponse, error) {
+    ids, err := s.bookmarkRepo.GetPostIDs(userID)
+    if err != nil {
+        return nil, err
+    }
+    return s.blogService.GetPublishedPostsByIDs(ids, opts)
+}
+
+func (s *BookmarkService) SaveBookmark(userID, postID uint) error {
+    published, err := s.blogRepo.IsPublished(postID)
+    if err != nil {
+        return err
+    }
+    if !published {
+        return ErrPostNotFound
+    }
+    return s.bookmarkRepo.Save(userID, postID)
+}
+
+// RemoveBookmark also takes the post out of the user's reading lists
+func (s *BookmarkService) RemoveBookmark(userID, postID uint) error {
+    return s.bookmarkRepo.Remove(userID, postID)
+}
+
+func (s *BookmarkService) GetReadingLists(userID uint) ([]models.ReadingListResponse, error) {
+    lists, err := s.readingListRepo.GetByUserID(userID)
+    if err != nil {
+        return nil, err
+    }
+
+    responses := []models.ReadingListResponse{}
+    for _, list := range lists {
+        responses = append(responses, *toReadingListResponse(list))
+    }
+    return responses, nil
+}
+
+// Lists are private, someone else's list is reported as missing
+func (s *BookmarkService) ownList(userID, listID uint) (*models.ReadingList, error) {
+    list, err := s.readingListRepo.GetByID(listID)
+    if err != nil || list.UserID != userID {
+        return nil, ErrReadingListNotFound
+    }
+    return list, nil
+}
+
+func (s *BookmarkService) GetReadingList(userID, listID uint, opts models.ReadOptions) (*models.ReadingListResponse, error) {
+    list, err := s.ownList(userID, listID)
+    if err != nil {
+        return nil, err
+    }
+
+    ids := make([]uint, 0, len(list.Items))
+    for _, item := range list.Items {
+        ids = append(ids, item.BlogPostID)
+    }
+    posts, err := s.blogService.GetPublishedPostsByIDs(ids, opts)
+    if err != nil {
+        return nil, err
+    }
+
+    response := toReadingListResponse(*list)
+    response.Posts = posts
+    return response, nil
+}
+
+func (s *BookmarkService) CreateReadingList(userID uint, req models.SaveReadingListRequest) (*models.ReadingListResponse, error) {
+    name := strings.TrimSpace(req.Name)
+    if name == "" {
+        return nil, errors.New("name is required")
+    }
+
+    list := &models.ReadingList{UserID: userID, Name: name}
+    if err := s.readingListRepo.Create(list); err != nil {
+        return nil, err
+    }
+    return toReadingListResponse(*list), nil
+}
+
+func (s *BookmarkService) RenameReadingList(userID, listID uint, req models.SaveReadingListRequest) (*models.ReadingListResponse, error) {
+    list, err := s.ownList(userID, listID)
+    if err != nil {
+        return nil, err
+    }
+
+    name := strings.TrimSpace(req.Name)
+    if name == "" {
+        return nil, errors.New("name is required")
+    }
+    list.Name = name
+
+    if err := s.readingListRepo.Update(list); err != nil {
+        return nil, err
+    }
+    return toReadingListResponse(*list), nil
+}
+
+func (s *BookmarkService) DeleteReadingList(userID, listID uint) error {
+    if _, err := s.ownList(userID, listID); err != nil {
+        return err
+    }
+    return s.readingListRepo.Delete(listID)
+}
+
+// AddToReadingList appends the post to the list, bookmarking it if it wasn't already
+func (s *BookmarkService) AddToReadingList(userID, listID, postID uint) error {
+    if _, err := s.ownList(userID, listID); err != nil {
+        return err
+    }
+    if err := s.SaveBookmark(userID, postID); err != nil {
+        return err
+    }
+    return s.readingListRepo.AddPost(listID, postID)
+}
+
+// RemoveFromReadingList keeps the bookmark, the post only leaves this list
+func (s *BookmarkService) RemoveFromReadingList(userID, listID, postID uint) error {
+    if _, err := s.ownList(userID, listID); err != nil {
+        return err
+    }
+    return s.readingListRepo.RemovePost(listID, postID)
+}
+
+// ReorderReadingList needs exactly the posts already in the list, in their new order
+func (s *BookmarkService) ReorderReadingList(userID, listID uint, req models.ReorderReadingListRequest) error {
+    list, err := s.ownList(userID, listID)
+    if err != nil {
+        return err
+    }
+
+    inList := map[uint]bool{}
+    for _, item := range list.Items {
+        inList[item.BlogPostID] = true
+    }
+    seen := map[uint]bool{}
+    for _, id := range req.PostIDs {
+        if !inList[id] || seen[id] {
+            return errors.New("post_ids must list each post in the reading list exactly once")
+        }
+        seen[id] = true
+    }
+    if len(seen) != len(inList) {
+        return errors.New("post_ids must list each post in the reading list exactly once")
+    }
+
+    return s.readingListRepo.Reorder(listID, req.PostIDs)
+}
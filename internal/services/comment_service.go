@@ -1,6 +1,8 @@
 package services
 
 import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/i18n"
     "auth2_google/internal/models"
     "auth2_google/internal/repositories"
     "errors"
@@ -8,32 +10,51 @@ import (
 )
 
 type CommentServiceInterface interface {
-    CreateComment(req models.CreateCommentRequest) (*models.CommentResponse, error)
-    GetCommentsByBlogPostID(blogPostID uint) ([]models.CommentResponse, error)
-    GetCommentByID(id uint) (*models.CommentResponse, error)
-    UpdateComment(id uint, req models.UpdateCommentRequest) (*models.CommentResponse, error)
+    CreateComment(req models.CreateCommentRequest, locale string) (*models.CommentResponse, error)
+    GetCommentsByBlogPostID(blogPostID uint, locale string) ([]models.CommentResponse, error)
+    GetCommentByID(id uint, locale string) (*models.CommentResponse, error)
+    UpdateComment(id uint, req models.UpdateCommentRequest, locale string) (*models.CommentResponse, error)
     DeleteComment(id uint) error
-    CreateReply(parentID uint, req models.CreateCommentRequest) (*models.CommentResponse, error)
-    GetReplies(parentID uint) ([]models.CommentResponse, error)
+    CreateReply(parentID uint, req models.CreateCommentRequest, locale string) (*models.CommentResponse, error)
+    GetReplies(parentID uint, locale string) ([]models.CommentResponse, error)
 }
 
+var ErrParentCommentNotFound = errors.New("parent comment not found")
+
 type CommentService struct {
     commentRepo repositories.CommentRepositoryInterface
+    blogRepo    repositories.BlogRepositoryInterface
 }
 
-func NewCommentService(commentRepo repositories.CommentRepositoryInterface) CommentServiceInterface {
+func NewCommentService(commentRepo repositories.CommentRepositoryInterface, blogRepo repositories.BlogRepositoryInterface) CommentServiceInterface {
     return &CommentService{
         commentRepo: commentRepo,
+        blogRepo:    blogRepo,
+    }
+}
+
+// Comments only go on live posts, not drafts or posts in the trash
+func (s *CommentService) requirePublished(postID uint) error {
+    published, err := s.blogRepo.IsPublished(postID)
+    if err != nil {
+        return err
+    }
+    if !published {
+        return ErrPostNotFound
     }
+    return nil
 }
 
-// Helper function to format date
-func formatCommentDate(t time.Time) string {
-    return t.Format("January 2, 2006 at 3:04 PM") // "June 23, 2025 at 4:30 PM"
+// created_at has always been this English format, clients depend on it
+const legacyCommentDate = "January 2, 2006 at 3:04 PM"
+
+// Helper function to format date in the site timezone
+func formatCommentDate(t time.Time, locale string) string {
+    return i18n.FormatDateTime(t.In(config.Timezone()), locale) // "June 23, 2025 at 4:30 PM"
 }
 
-// Convert to response format with nested replies
-func (s *CommentService) toResponse(comment models.Comment) models.CommentResponse {
+// Convert to response format with nested replies, dates in the given locale
+func (s *CommentService) toResponse(comment models.Comment, locale string) models.CommentResponse {
     response := models.CommentResponse{
         ID:         comment.ID,
         BlogPostID: comment.BlogPostID,
@@ -41,23 +62,30 @@ func (s *CommentService) toResponse(comment models.Comment) models.CommentRespon
         Email:      comment.Email,
         Text:       comment.Text,
         ParentID:   comment.ParentID,
-        CreatedAt:  formatCommentDate(comment.CreatedAt),
+        CreatedAt:  comment.CreatedAt.Format(legacyCommentDate),
+        Date:       formatCommentDate(comment.CreatedAt, locale),
         Replies:    []models.CommentResponse{},
+
+        CreatedAtISO: isoTime(comment.CreatedAt),
+        RelativeDate: relativeDate(comment.CreatedAt, locale),
     }
 
     // Convert replies to response format
     for _, reply := range comment.Replies {
-        replyResponse := s.toResponse(reply) // Recursive for nested replies
+        replyResponse := s.toResponse(reply, locale) // Recursive for nested replies
         response.Replies = append(response.Replies, replyResponse)
     }
 
     return response
 }
 
-func (s *CommentService) CreateComment(req models.CreateCommentRequest) (*models.CommentResponse, error) {
+func (s *CommentService) CreateComment(req models.CreateCommentRequest, locale string) (*models.CommentResponse, error) {
     if req.Name == "" || req.Text == "" {
         return nil, errors.New("name and text are required")
     }
+    if err := s.requirePublished(req.BlogPostID); err != nil {
+        return nil, err
+    }
 
     comment := &models.Comment{
         BlogPostID: req.BlogPostID,
@@ -72,11 +100,11 @@ func (s *CommentService) CreateComment(req models.CreateCommentRequest) (*models
         return nil, err
     }
 
-    response := s.toResponse(*comment)
+    response := s.toResponse(*comment, locale)
     return &response, nil
 }
 
-func (s *CommentService) GetCommentsByBlogPostID(blogPostID uint) ([]models.CommentResponse, error) {
+func (s *CommentService) GetCommentsByBlogPostID(blogPostID uint, locale string) ([]models.CommentResponse, error) {
     comments, err := s.commentRepo.GetByBlogPostID(blogPostID)
     if err != nil {
         return nil, err
@@ -84,23 +112,23 @@ func (s *CommentService) GetCommentsByBlogPostID(blogPostID uint) ([]models.Comm
 
     var responses []models.CommentResponse
     for _, comment := range comments {
-        responses = append(responses, s.toResponse(comment))
+        responses = append(responses, s.toResponse(comment, locale))
     }
 
     return responses, nil
 }
 
-func (s *CommentService) GetCommentByID(id uint) (*models.CommentResponse, error) {
+func (s *CommentService) GetCommentByID(id uint, locale string) (*models.CommentResponse, error) {
     comment, err := s.commentRepo.GetByID(id)
     if err != nil {
         return nil, errors.New("comment not found")
     }
 
-    response := s.toResponse(*comment)
+    response := s.toResponse(*comment, locale)
     return &response, nil
 }
 
-func (s *CommentService) UpdateComment(id uint, req models.UpdateCommentRequest) (*models.CommentResponse, error) {
+func (s *CommentService) UpdateComment(id uint, req models.UpdateCommentRequest, locale string) (*models.CommentResponse, error) {
     comment, err := s.commentRepo.GetByID(id)
     if err != nil {
         return nil, errors.New("comment not found")
@@ -115,7 +143,7 @@ func (s *CommentService) UpdateComment(id uint, req models.UpdateCommentRequest)
         return nil, err
     }
 
-    response := s.toResponse(*comment)
+    response := s.toResponse(*comment, locale)
     return &response, nil
 }
 
@@ -128,7 +156,7 @@ func (s *CommentService) DeleteComment(id uint) error {
     return s.commentRepo.Delete(id)
 }
 
-func (s *CommentService) CreateReply(parentID uint, req models.CreateCommentRequest) (*models.CommentResponse, error) {
+func (s *CommentService) CreateReply(parentID uint, req models.CreateCommentRequest, locale string) (*models.CommentResponse, error) {
     if req.Name == "" || req.Text == "" {
         return nil, errors.New("name and text are required")
     }
@@ -136,7 +164,10 @@ func (s *CommentService) CreateReply(parentID uint, req models.CreateCommentRequ
     // Verify parent comment exists
     parentComment, err := s.commentRepo.GetByID(parentID)
     if err != nil {
-        return nil, errors.New("parent comment not found")
+        return nil, ErrParentCommentNotFound
+    }
+    if err := s.requirePublished(parentComment.BlogPostID); err != nil {
+        return nil, err
     }
 
     reply := &models.Comment{
@@ -152,11 +183,11 @@ func (s *CommentService) CreateReply(parentID uint, req models.CreateCommentRequ
         return nil, err
     }
 
-    response := s.toResponse(*reply)
+    response := s.toResponse(*reply, locale)
     return &response, nil
 }
 
-func (s *CommentService) GetReplies(parentID uint) ([]models.CommentResponse, error) {
+func (s *CommentService) GetReplies(parentID uint, locale string) ([]models.CommentResponse, error) {
     replies, err := s.commentRepo.GetReplies(parentID)
     if err != nil {
         return nil, err
@@ -164,7 +195,7 @@ func (s *CommentService) GetReplies(parentID uint) ([]models.CommentResponse, er
 
     var responses []models.CommentResponse
     for _, reply := range replies {
-        responses = append(responses, s.toResponse(reply))
+        responses = append(responses, s.toResponse(reply, locale))
     }
 
     return responses, nil
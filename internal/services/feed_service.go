@@ -0,0 +1,253 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/feeds"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "errors"
+    "fmt"
+    "time"
+)
+
+var ErrFeedNotFound = errors.New("feed not found")
+
+const feedItemLimit = 50
+
+type FeedServiceInterface interface {
+    BuildFeed(format string, filter models.FeedFilter, fullContent bool) ([]byte, time.Time, error)
+}
+
+type FeedService struct {
+    blogRepo repositories.BlogRepositoryInterface
+    tagRepo  repositories.TagRepositoryInterface
+    userRepo repositories.UserRepositoryInterface
+}
+
+func NewFeedService(blogRepo repositories.BlogRepositoryInterface, tagRepo repositories.TagRepositoryInterface, userRepo repositories.UserRepositoryInterface) FeedServiceInterface {
+    return &FeedService{
+        blogRepo: blogRepo,
+        tagRepo:  tagRepo,
+        userRepo: userRepo,
+    }
+}
+
+// Everything the three formats need, worked out once
+type feedInfo struct {
+    title    string
+    homeURL  string
+    basePath string // Feed URL without the file name
+    posts    []models.BlogPost
+    updated  time.Time
+}
+
+// siteLastModified is the Last-Modified for a response whose own content changed at
+// built. The newest item date alone stays put when a post is unpublished or trashed,
+// so the site's last change, which does move then, is taken into account.
+func siteLastModified(blogRepo repositories.BlogRepositoryInterface, built time.Time) (time.Time, error) {
+    last, err := blogRepo.LastChange()
+    if err != nil {
+        return time.Time{}, err
+    }
+    if built.After(last) {
+        return built, nil
+    }
+    return last, nil
+}
+
+// BuildFeed renders a syndication feed and returns when its content last changed
+func (s *FeedService) BuildFeed(format string, filter models.FeedFilter, fullContent bool) ([]byte, time.Time, error) {
+    info := feedInfo{
+        title:    config.SiteTitle(),
+        homeURL:  config.FrontendURL(),
+        basePath: config.PublicBaseURL() + "/feeds",
+    }
+
+    switch {
+    case filter.TagSlug != "":
+        tag, err := s.tagRepo.GetBySlug(filter.TagSlug)
+        if err != nil {
+            return nil, time.Time{}, ErrFeedNotFound
+        }
+        info.title += " – " + tag.Name
+        info.homeURL += "/tags/" + tag.Slug
+        info.basePath += "/tags/" + tag.Slug
+    case filter.AuthorID != 0:
+        author, err := s.userRepo.GetByID(filter.AuthorID)
+        if err != nil {
+            return nil, time.Time{}, ErrFeedNotFound
+        }
+        info.title += " – " + author.Name
+        info.homeURL += fmt.Sprintf("/authors/%d", author.ID)
+        info.basePath += fmt.Sprintf("/authors/%d", author.ID)
+    }
+
+    posts, err := s.blogRepo.GetPublishedForFeed(filter, feedItemLimit)
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+    info.posts = posts
+    for _, post := range posts {
+        if post.UpdatedAt.After(info.updated) {
+            info.updated = post.UpdatedAt
+        }
+    }
+
+    var body []byte
+    switch format {
+    case models.FeedRSS:
+        body, err = feeds.Marshal(s.buildRSS(info, fullContent))
+    case models.FeedAtom:
+        body, err = feeds.Marshal(s.buildAtom(info, fullContent))
+    case models.FeedJSON:
+        body, err = feeds.MarshalJSONFeed(s.buildJSONFeed(info, fullContent))
+    default:
+        return nil, time.Time{}, ErrFeedNotFound
+    }
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+    lastModified, err := siteLastModified(s.blogRepo, info.updated)
+    return body, lastModified, err
+}
+
+func publishedAt(post models.BlogPost) time.Time {
+    if post.PublishedAt != nil {
+        return *post.PublishedAt
+    }
+    return post.CreatedAt
+}
+
+func authorNames(post models.BlogPost) []string {
+    if len(post.Authors) == 0 {
+        return []string{post.Author} // Legacy posts only have the free-text name
+    }
+    names := []string{}
+    for _, author := range post.Authors {
+        names = append(names, author.User.Name)
+    }
+    return names
+}
+
+func tagNames(post models.BlogPost) []string {
+    names := []string{}
+    for _, tag := range post.Tags {
+        names = append(names, tag.Name)
+    }
+    return names
+}
+
+func (s *FeedService) buildRSS(info feedInfo, fullContent bool) feeds.RSS {
+    channel := feeds.RSSChannel{
+        Title:       info.title,
+        Link:        info.homeURL,
+        AtomLink:    feeds.AtomLink{Href: info.basePath + "/rss.xml", Rel: "self", Type: "application/rss+xml"},
+        Description: config.SiteDescription(),
+        Items:       []feeds.RSSItem{},
+    }
+    if !info.updated.IsZero() {
+        channel.LastBuildDate = info.updated.Format(time.RFC1123Z)
+    }
+
+    for _, post := range info.posts {
+        item := feeds.RSSItem{
+            Title:       post.Title,
+            Link:        config.PostURL(post.ID),
+            GUID:        feeds.GUID{IsPermaLink: true, Value: config.PostURL(post.ID)},
+            PubDate:     publishedAt(post).Format(time.RFC1123Z),
+            Description: post.Excerpt,
+            Creators:    authorNames(post),
+            Categories:  tagNames(post),
+        }
+        if fullContent && post.Content != "" {
+            item.Content = &feeds.CDATA{Value: post.Content}
+        }
+        if post.Audio != nil {
+            audio := toAudioResponse(*post.Audio)
+            item.Enclosure = &feeds.Enclosure{URL: audio.URL, Length: audio.Size, Type: audio.ContentType}
+        }
+        channel.Items = append(channel.Items, item)
+    }
+    return feeds.NewRSS(channel)
+}
+
+func (s *FeedService) buildAtom(info feedInfo, fullContent bool) feeds.AtomFeed {
+    // Atom requires <updated>. An empty feed gets a fixed date so its body,
+    // and with it the ETag, stays the same between requests.
+    updated := info.updated
+    if updated.IsZero() {
+        updated = time.Unix(0, 0)
+    }
+
+    feed := feeds.AtomFeed{
+        ID:       info.basePath + "/atom.xml",
+        Title:    info.title,
+        Subtitle: config.SiteDescription(),
+        Updated:  updated.UTC().Format(time.RFC3339),
+        Links: []feeds.AtomLink{
+            {Href: info.basePath + "/atom.xml", Rel: "self", Type: "application/atom+xml"},
+            {Href: info.homeURL, Rel: "alternate", Type: "text/html"},
+        },
+        Entries: []feeds.AtomEntry{},
+    }
+
+    for _, post := range info.posts {
+        entry := feeds.AtomEntry{
+            ID:        config.PostURL(post.ID),
+            Title:     post.Title,
+            Updated:   post.UpdatedAt.UTC().Format(time.RFC3339),
+            Published: publishedAt(post).UTC().Format(time.RFC3339),
+            Links:     []feeds.AtomLink{{Href: config.PostURL(post.ID), Rel: "alternate", Type: "text/html"}},
+            Summary:   &feeds.AtomText{Type: "text", Value: post.Excerpt},
+        }
+        for _, name := range authorNames(post) {
+            entry.Authors = append(entry.Authors, feeds.AtomPerson{Name: name})
+        }
+        for _, tag := range post.Tags {
+            entry.Categories = append(entry.Categories, feeds.AtomCategory{Term: tag.Slug, Label: tag.Name})
+        }
+        if fullContent && post.Content != "" {
+            entry.Content = &feeds.AtomText{Type: "html", Value: post.Content}
+        }
+        feed.Entries = append(feed.Entries, entry)
+    }
+    return feeds.NewAtomFeed(feed)
+}
+
+func (s *FeedService) buildJSONFeed(info feedInfo, fullContent bool) feeds.JSONFeed {
+    feed := feeds.JSONFeed{
+        Title:       info.title,
+        HomePageURL: info.homeURL,
+        FeedURL:     info.basePath + "/feed.json",
+        Description: config.SiteDescription(),
+        Items:       []feeds.JSONFeedItem{},
+    }
+
+    for _, post := range info.posts {
+        item := feeds.JSONFeedItem{
+            ID:            config.PostURL(post.ID),
+            URL:           config.PostURL(post.ID),
+            Title:         post.Title,
+            Summary:       post.Excerpt,
+            Image:         post.Image,
+            DatePublished: publishedAt(post).Format(time.RFC3339),
+            DateModified:  post.UpdatedAt.Format(time.RFC3339),
+            Tags:          tagNames(post),
+        }
+        // Items need either content_html or content_text
+        if fullContent && post.Content != "" {
+            item.ContentHTML = post.Content
+        } else {
+            item.ContentText = post.Excerpt
+        }
+
+        if len(post.Authors) == 0 {
+            item.Authors = []feeds.JSONFeedAuthor{{Name: post.Author}}
+        }
+        for _, author := range toAuthorResponses(post.Authors) {
+            item.Authors = append(item.Authors, feeds.JSONFeedAuthor{Name: author.Name, URL: author.ProfileURL, Avatar: author.Avatar})
+        }
+        feed.Items = append(feed.Items, item)
+    }
+    return feed
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/utils"
+    "errors"
+    "strconv"
+    "time"
+)
+
+var ErrSlotNotFound = errors.New("homepage slot not found")
+
+// Posts per automatic slot when the slot doesn't say
+const (
+    homepageSlotDefaultPosts = 6
+    homepageSlotMaxPosts     = 50
+)
+
+type HomepageServiceInterface interface {
+    FeaturePost(postID uint, req models.FeaturePostRequest) (*models.FeaturedPostResponse, error)
+    UnfeaturePost(postID uint) error
+    GetFeaturedPosts(limit int, opts models.ReadOptions) ([]models.BlogPostResponse, error)
+
+    GetHomepage(opts models.ReadOptions) ([]models.HomepageSlotResponse, error)
+    GetSlots() ([]models.HomepageSlotResponse, error)
+    SaveSlot(name string, req models.SaveHomepageSlotRequest) (*models.HomepageSlotResponse, error)
+    DeleteSlot(name string) error
+}
+
+type HomepageService struct {
+    homepageRepo repositories.HomepageRepositoryInterface
+    blogRepo     repositories.BlogRepositoryInterface
+    blogService  BlogServiceInterface
+}
+
+func NewHomepageService(homepageRepo repositories.HomepageRepositoryInterface, blogRepo repositories.BlogRepositoryInterface, blogService BlogServiceInterface) HomepageServiceInterface {
+    return &HomepageService{
+        homepageRepo: homepageRepo,
+        blogRepo:     blogRepo,
+        blogService:  blogService,
+    }
+}
+
+func toHomepageSlotResponse(slot models.HomepageSlot) *models.HomepageSlotResponse {
+    return &models.HomepageSlotResponse{
+        Name:     slot.Name,
+        Title:    slot.Title,
+        Position: slot.Position,
+        Source:   slot.Source,
+        TagSlug:  slot.TagSlug,
+        MaxPosts: slot.MaxPosts,
+    }
+}
+
+// FeaturePost features a post, or moves it if it already is. Drafts can be
+// featured ahead of time, they show up once published.
+func (s *HomepageService) FeaturePost(postID uint, req models.FeaturePostRequest) (*models.FeaturedPostResponse, error) {
+    if _, err := s.blogRepo.GetByID(postID); err != nil {
+        return nil, ErrPostNotFound
+    }
+    if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+        return nil, errors.New("expires_at must be in the future")
+    }
+
+    featured := &models.FeaturedPost{BlogPostID: postID, Position: req.Position, ExpiresAt: req.ExpiresAt}
+    if err := s.homepageRepo.SetFeatured(featured); err != nil {
+        return nil, err
+    }
+
+    response := &models.FeaturedPostResponse{PostID: postID, Position: req.Position}
+    if req.ExpiresAt != nil {
+        response.ExpiresAt = isoTime(*req.ExpiresAt)
+    }
+    return response, nil
+}
+
+func (s *HomepageService) UnfeaturePost(postID uint) error {
+    return s.homepageRepo.RemoveFeatured(postID)
+}
+
+// GetFeaturedPosts returns published, unexpired featured posts by position
+func (s *HomepageService) GetFeaturedPosts(limit int, opts models.ReadOptions) ([]models.BlogPostResponse, error) {
+    ids, err := s.homepageRepo.GetFeaturedPostIDs(time.Now())
+    if err != nil {
+        return nil, err
+    }
+
+    posts, err := s.blogService.GetPublishedPostsByIDs(ids, opts)
+    if err != nil {
+        return nil, err
+    }
+    if limit > 0 && len(posts) > limit {
+        posts = posts[:limit]
+    }
+    return posts, nil
+}
+
+// GetHomepage fills every slot in page order. Automatic slots skip posts an
+// earlier slot already shows, so "latest" doesn't repeat the hero post.
+func (s *HomepageService) GetHomepage(opts models.ReadOptions) ([]models.HomepageSlotResponse, error) {
+    slots, err := s.homepageRepo.GetSlots()
+    if err != nil {
+        return nil, err
+    }
+
+    shown := map[uint]bool{}
+    responses := []models.HomepageSlotResponse{}
+    for _, slot := range slots {
+        ids, err := s.slotPostIDs(slot, shown)
+        if err != nil {
+            return nil, err
+        }
+        posts, err := s.blogService.GetPublishedPostsByIDs(ids, opts)
+        if err != nil {
+            return nil, err
+        }
+
+        limit := slot.MaxPosts
+        if limit == 0 && slot.Source != models.SlotSourceManual {
+            limit = homepageSlotDefaultPosts
+        }
+        if limit > 0 && len(posts) > limit {
+            posts = posts[:limit]
+        }
+        for _, post := range posts {
+            if id, err := strconv.ParseUint(post.ID, 10, 32); err == nil {
+                shown[uint(id)] = true
+            }
+        }
+
+        response := toHomepageSlotResponse(slot)
+        response.Posts = posts
+        responses = append(responses, *response)
+    }
+    return responses, nil
+}
+
+// Candidate post IDs for a slot, in display order. Unpublished ones are dropped later.
+func (s *HomepageService) slotPostIDs(slot models.HomepageSlot, shown map[uint]bool) ([]uint, error) {
+    if slot.Source == models.SlotSourceManual {
+        ids := []uint{}
+        for _, post := range slot.Posts {
+            ids = append(ids, post.BlogPostID)
+        }
+        return ids, nil
+    }
+
+    var candidates []uint
+    switch slot.Source {
+    case models.SlotSourceFeatured:
+        ids, err := s.homepageRepo.GetFeaturedPostIDs(time.Now())
+        if err != nil {
+            return nil, err
+        }
+        candidates = ids
+    case models.SlotSourceLatest, models.SlotSourceTag:
+        limit := slot.MaxPosts
+        if limit == 0 {
+            limit = homepageSlotDefaultPosts
+        }
+        filter := models.FeedFilter{}
+        if slot.Source == models.SlotSourceTag {
+            filter.TagSlug = slot.TagSlug
+        }
+        // Fetch enough that skipping posts shown above still fills the slot
+        posts, err := s.blogRepo.GetPublishedForFeed(filter, limit+len(shown))
+        if err != nil {
+            return nil, err
+        }
+        for _, post := range posts {
+            candidates = append(candidates, post.ID)
+        }
+    }
+
+    ids := []uint{}
+    for _, id := range candidates {
+        if !shown[id] {
+            ids = append(ids, id)
+        }
+    }
+    return ids, nil
+}
+
+// GetSlots is the editor view: the slot settings without the posts filled in
+func (s *HomepageService) GetSlots() ([]models.HomepageSlotResponse, error) {
+    slots, err := s.homepageRepo.GetSlots()
+    if err != nil {
+        return nil, err
+    }
+
+    responses := []models.HomepageSlotResponse{}
+    for _, slot := range slots {
+        response := toHomepageSlotResponse(slot)
+        if slot.Source == models.SlotSourceManual {
+            response.PostIDs = []uint{}
+            for _, post := range slot.Posts {
+                response.PostIDs = append(response.PostIDs, post.BlogPostID)
+            }
+        }
+        responses = append(responses, *response)
+    }
+    return responses, nil
+}
+
+// SaveSlot creates the named slot or replaces its settings
+func (s *HomepageService) SaveSlot(name string, req models.SaveHomepageSlotRequest) (*models.HomepageSlotResponse, error) {
+    if name == "" || utils.Slugify(name) != name {
+        return nil, errors.New("slot names are lowercase words joined by dashes, e.g. editors-picks")
+    }
+
+    source := req.Source
+    if source == "" {
+        source = models.SlotSourceManual
+    }
+    switch source {
+    case models.SlotSourceManual, models.SlotSourceFeatured, models.SlotSourceLatest:
+    case models.SlotSourceTag:
+        if req.TagSlug == "" {
+            return nil, errors.New("tag_slug is required for tag slots")
+        }
+    default:
+        return nil, errors.New("source must be manual, featured, latest or tag")
+    }
+    if req.MaxPosts < 0 || req.MaxPosts > homepageSlotMaxPosts {
+        return nil, errors.New("max_posts must be between 0 and 50")
+    }
+
+    postIDs := []uint{}
+    if source == models.SlotSourceManual {
+        seen := map[uint]bool{}
+        for _, id := range req.PostIDs {
+            if seen[id] {
+                return nil, errors.New("a post can only appear once in a slot")
+            }
+            seen[id] = true
+        }
+        posts, err := s.blogRepo.GetByIDs(req.PostIDs)
+        if err != nil {
+            return nil, err
+        }
+        if len(posts) != len(req.PostIDs) {
+            return nil, ErrPostNotFound
+        }
+        postIDs = req.PostIDs
+    }
+
+    slot := &models.HomepageSlot{
+        Name:     name,
+        Title:    req.Title,
+        Position: req.Position,
+        Source:   source,
+        MaxPosts: req.MaxPosts,
+    }
+    if source == models.SlotSourceTag {
+        slot.TagSlug = req.TagSlug
+    }
+    if err := s.homepageRepo.SaveSlot(slot, postIDs); err != nil {
+        return nil, err
+    }
+
+    response := toHomepageSlotResponse(*slot)
+    if source == models.SlotSourceManual {
+        response.PostIDs = postIDs
+    }
+    return response, nil
+}
+
+func (s *HomepageService) DeleteSlot(name string) error {
+    slot, err := s.homepageRepo.GetSlotByName(name)
+    if err != nil {
+        return ErrSlotNotFound
+    }
+    return s.homepageRepo.DeleteSlot(slot.ID)
+}
@@ -0,0 +1,478 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+    "auth2_google/internal/utils"
+    "auth2_google/internal/wordpress"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "log"
+    "net"
+    "net/http"
+    "net/url"
+    "path"
+    "strings"
+    "syscall"
+    "time"
+)
+
+// Shared address space (RFC 6598), not covered by net.IP.IsPrivate
+var carrierNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// publicIP reports whether ip is an ordinary internet address, not loopback,
+// private, link-local (cloud metadata lives there) or otherwise special
+func publicIP(ip net.IP) bool {
+    return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+        ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || carrierNAT.Contains(ip))
+}
+
+// newImportClient fetches images named in an uploaded export file. Every
+// connection, redirects included, is checked after DNS resolution, so the file
+// can't point the server at itself or the internal network.
+func newImportClient() *http.Client {
+    dialer := &net.Dialer{
+        Timeout: 10 * time.Second,
+        Control: func(network, address string, _ syscall.RawConn) error {
+            host, _, err := net.SplitHostPort(address)
+            if err != nil {
+                return err
+            }
+            if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+                return fmt.Errorf("refusing to connect to non-public address %s", host)
+            }
+            return nil
+        },
+    }
+    return &http.Client{
+        Timeout: 30 * time.Second,
+        // No proxy, the check above has to see the real destination
+        Transport: &http.Transport{
+            DialContext:         dialer.DialContext,
+            TLSHandshakeTimeout: 10 * time.Second,
+        },
+        CheckRedirect: func(req *http.Request, via []*http.Request) error {
+            if len(via) >= 5 {
+                return errors.New("too many redirects")
+            }
+            if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+                return errors.New("redirected to something that isn't a web address")
+            }
+            return nil
+        },
+    }
+}
+
+type ImportServiceInterface interface {
+    ImportWordPress(ctx context.Context, r io.Reader, userID uint) (*models.ImportReport, error)
+}
+
+type ImportService struct {
+    importRepo    repositories.ImportRepositoryInterface
+    blogRepo      repositories.BlogRepositoryInterface
+    tagRepo       repositories.TagRepositoryInterface
+    userRepo      repositories.UserRepositoryInterface
+    mediaRepo     repositories.MediaRepositoryInterface
+    uploadService UploadServiceInterface
+    searchIndex   search.SearchIndex
+    related       *search.RelatedIndex
+    client        *http.Client
+}
+
+func NewImportService(importRepo repositories.ImportRepositoryInterface, blogRepo repositories.BlogRepositoryInterface, tagRepo repositories.TagRepositoryInterface, userRepo repositories.UserRepositoryInterface, mediaRepo repositories.MediaRepositoryInterface, uploadService UploadServiceInterface, searchIndex search.SearchIndex, related *search.RelatedIndex) ImportServiceInterface {
+    return &ImportService{
+        importRepo:    importRepo,
+        blogRepo:      blogRepo,
+        tagRepo:       tagRepo,
+        userRepo:      userRepo,
+        mediaRepo:     mediaRepo,
+        uploadService: uploadService,
+        searchIndex:   searchIndex,
+        related:       related,
+        client:        newImportClient(),
+    }
+}
+
+// An image already in our storage
+type importedImage struct {
+    ID  uint
+    URL string
+}
+
+// wordpressImport is the state of one import run
+type wordpressImport struct {
+    ctx      context.Context
+    userID   uint // Owner of downloaded images
+    source   string
+    language string
+    report   *models.ImportReport
+
+    authors     map[string]wordpress.Author // By login
+    users       map[string]*models.User     // By email, nil when there's no account
+    attachments map[string]string           // Attachment post ID to file URL
+    posts       map[string]uint
+    comments    map[string]uint
+    images      map[string]importedImage
+    imageIDs    map[string]uint // Recorded by earlier runs
+    tags        map[string]bool
+}
+
+func (run *wordpressImport) warn(format string, args ...interface{}) {
+    message := fmt.Sprintf(format, args...)
+    log.Printf("⚠️ WordPress import: %s", message)
+    run.report.Warnings = append(run.report.Warnings, message)
+}
+
+// ImportWordPress brings posts, comments, tags, categories, authors and
+// images over from a WXR export. Items imported by an earlier run of the
+// same site's export are skipped, so it's safe to run again.
+func (s *ImportService) ImportWordPress(ctx context.Context, r io.Reader, userID uint) (*models.ImportReport, error) {
+    export, err := wordpress.Parse(r)
+    if err != nil {
+        return nil, err
+    }
+    channel := export.Channel
+
+    run := &wordpressImport{
+        ctx:         ctx,
+        userID:      userID,
+        source:      channel.SiteURL(),
+        language:    config.DefaultLanguage(),
+        report:      &models.ImportReport{Source: channel.SiteURL(), Warnings: []string{}},
+        authors:     map[string]wordpress.Author{},
+        users:       map[string]*models.User{},
+        attachments: map[string]string{},
+        images:      map[string]importedImage{},
+        tags:        map[string]bool{},
+    }
+
+    // "en-US" is stored as "en"
+    if lang := strings.ToLower(strings.SplitN(channel.Language, "-", 2)[0]); config.IsSupportedLanguage(lang) {
+        run.language = lang
+    }
+    for _, author := range channel.Authors {
+        run.authors[author.Login] = author
+    }
+    for _, item := range channel.Items {
+        if item.PostType == "attachment" && item.AttachmentURL != "" {
+            run.attachments[item.PostID] = item.AttachmentURL
+        }
+    }
+
+    if run.posts, err = s.importRepo.GetLocalIDs(run.source, models.ImportPost); err != nil {
+        return nil, err
+    }
+    if run.comments, err = s.importRepo.GetLocalIDs(run.source, models.ImportComment); err != nil {
+        return nil, err
+    }
+    if run.imageIDs, err = s.importRepo.GetLocalIDs(run.source, models.ImportImage); err != nil {
+        return nil, err
+    }
+
+    created := []uint{}
+    for _, item := range channel.Items {
+        if item.PostType != "post" {
+            continue
+        }
+        if err := ctx.Err(); err != nil {
+            return run.report, err
+        }
+
+        postID, isNew := s.importPost(run, item)
+        if postID == 0 {
+            continue
+        }
+        if isNew {
+            created = append(created, postID)
+        }
+        s.importComments(run, postID, item)
+    }
+    run.report.Tags = len(run.tags)
+
+    // Search and related posts only need updating for new posts
+    for _, id := range created {
+        if post, err := s.blogRepo.GetByID(id); err == nil {
+            if err := s.searchIndex.Index(*post); err != nil {
+                log.Printf("⚠️ Failed to index post %d: %v", id, err)
+            }
+        }
+    }
+    if len(created) > 0 {
+        s.related.Invalidate()
+    }
+
+    log.Printf("✅ WordPress import from %s: %d posts, %d comments, %d images", run.source,
+        run.report.Posts.Created, run.report.Comments.Created, run.report.Images.Created)
+    return run.report, nil
+}
+
+// importPost creates the post unless an earlier run did. It returns the
+// local post ID (0 when the item isn't imported) and whether it's new.
+func (s *ImportService) importPost(run *wordpressImport, item wordpress.Item) (uint, bool) {
+    if id, ok := run.posts[item.PostID]; ok {
+        run.report.Posts.Skipped++
+        return id, false
+    }
+
+    var published bool
+    switch item.Status {
+    case "publish":
+        published = true
+    case "draft", "pending", "private", "future":
+        // Kept as drafts, we have no private or scheduled posts
+    default:
+        run.report.Posts.Skipped++ // trash, auto-draft
+        return 0, false
+    }
+
+    content := wordpress.ToHTML(item.Content())
+    urls := map[string]string{}
+    for _, link := range wordpress.ImageURLs(content) {
+        if !wordpress.SameSite(link, run.source) {
+            continue
+        }
+        if image, ok := s.importImage(run, link); ok {
+            urls[link] = image.URL
+        }
+    }
+    content = wordpress.ReplaceImages(content, urls)
+
+    title := strings.TrimSpace(item.Title)
+    if title == "" {
+        title = utils.Truncate(utils.StripHTML(content), 80)
+    }
+    if title == "" {
+        title = "Untitled"
+    }
+    excerpt := utils.StripHTML(item.Excerpt())
+    if excerpt == "" {
+        excerpt = utils.Truncate(utils.StripHTML(content), 200)
+    }
+
+    post := &models.BlogPost{
+        Title:     title,
+        Excerpt:   excerpt,
+        Content:   content,
+        Language:  run.language,
+        Published: published,
+        CreatedAt: item.Created(),
+        UpdatedAt: item.Modified(),
+    }
+    post.WordCount, post.ReadingMinutes = utils.ReadingStats(content)
+    if published && !post.CreatedAt.IsZero() {
+        publishedAt := post.CreatedAt
+        post.PublishedAt = &publishedAt
+    } else if published {
+        setPublished(post, true)
+    }
+
+    // Authors are linked to accounts with the same email, otherwise only their name is kept
+    author := run.authors[item.Creator]
+    post.Author = author.DisplayName
+    if post.Author == "" {
+        post.Author = item.Creator
+    }
+    if user := s.findUser(run, author.Email); user != nil {
+        post.AuthorID = &user.ID
+        post.Author = user.Name
+        post.Authors = []models.PostAuthor{{UserID: user.ID}}
+    }
+
+    if thumbnailID := item.MetaValue("_thumbnail_id"); thumbnailID != "" {
+        if link, ok := run.attachments[thumbnailID]; ok {
+            if !wordpress.SameSite(link, run.source) {
+                post.Image = link
+            } else if image, ok := s.importImage(run, link); ok {
+                post.Image = image.URL
+                post.ImageID = &image.ID
+            } else {
+                post.Image = link
+            }
+        }
+    }
+
+    names := []string{}
+    for _, category := range item.Categories {
+        if (category.Domain == "category" || category.Domain == "post_tag") && category.Nicename != "uncategorized" {
+            names = append(names, strings.TrimSpace(category.Name))
+        }
+    }
+    tags, err := s.tagRepo.FindOrCreate(names)
+    if err != nil {
+        run.warn("post %s (%q): failed to create tags: %v", item.PostID, title, err)
+        run.report.Posts.Failed++
+        return 0, false
+    }
+    post.Tags = tags
+
+    if err := s.importRepo.CreatePost(post, run.source, item.PostID); err != nil {
+        run.warn("post %s (%q): %v", item.PostID, title, err)
+        run.report.Posts.Failed++
+        return 0, false
+    }
+    for _, tag := range tags {
+        run.tags[tag.Slug] = true
+    }
+
+    run.posts[item.PostID] = post.ID
+    run.report.Posts.Created++
+    return post.ID, true
+}
+
+func (s *ImportService) findUser(run *wordpressImport, email string) *models.User {
+    email = strings.ToLower(strings.TrimSpace(email))
+    if email == "" {
+        return nil
+    }
+    if user, ok := run.users[email]; ok {
+        return user
+    }
+    user, err := s.userRepo.GetByEmail(email)
+    if err != nil {
+        user = nil
+    }
+    run.users[email] = user
+    return user
+}
+
+// importImage copies an image from the old site into our storage, once
+func (s *ImportService) importImage(run *wordpressImport, link string) (importedImage, bool) {
+    if image, ok := run.images[link]; ok {
+        return image, image.ID != 0
+    }
+
+    if id, ok := run.imageIDs[link]; ok {
+        if media, err := s.mediaRepo.GetByID(id); err == nil {
+            image := importedImage{ID: media.ID, URL: media.URL}
+            run.images[link] = image
+            run.report.Images.Skipped++
+            return image, true
+        }
+    }
+
+    media, err := s.downloadImage(run, link)
+    if err != nil {
+        run.warn("image %s: %v", link, err)
+        run.images[link] = importedImage{} // Don't try again in this run
+        run.report.Images.Failed++
+        return importedImage{}, false
+    }
+
+    if err := s.importRepo.RecordImage(run.source, link, media.ID); err != nil {
+        log.Printf("⚠️ Failed to record imported image %s: %v", link, err)
+    }
+    image := importedImage{ID: media.ID, URL: media.URL}
+    run.images[link] = image
+    run.report.Images.Created++
+    return image, true
+}
+
+func (s *ImportService) downloadImage(run *wordpressImport, link string) (*models.MediaResponse, error) {
+    parsed, err := url.Parse(link)
+    if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+        return nil, fmt.Errorf("not a web address")
+    }
+
+    req, err := http.NewRequestWithContext(run.ctx, http.MethodGet, link, nil)
+    if err != nil {
+        return nil, err
+    }
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return nil, err
+    }
+    defer resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {
+        return nil, fmt.Errorf("download failed: %s", resp.Status)
+    }
+
+    maxBytes := s.uploadService.MaxImageBytes()
+    data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+    if err != nil {
+        return nil, err
+    }
+    return s.uploadService.UploadImage(run.ctx, run.userID, path.Base(parsed.Path), data)
+}
+
+// importComments adds the post's approved comments that aren't imported yet.
+// Parents are created before their replies so threads keep their shape.
+func (s *ImportService) importComments(run *wordpressImport, postID uint, item wordpress.Item) {
+    pending := map[string]wordpress.Comment{}
+    order := []string{}
+    for _, comment := range item.Comments {
+        if _, ok := run.comments[comment.ID]; ok {
+            run.report.Comments.Skipped++
+            continue
+        }
+        if comment.Approved != "1" || (comment.Type != "" && comment.Type != "comment") {
+            run.report.Comments.Skipped++ // Unapproved, spam, pingbacks and trackbacks
+            continue
+        }
+        pending[comment.ID] = comment
+        order = append(order, comment.ID)
+    }
+
+    for len(pending) > 0 {
+        progress := false
+        for _, id := range order {
+            comment, ok := pending[id]
+            if !ok {
+                continue
+            }
+
+            var parentID *uint
+            if comment.ParentID != "" && comment.ParentID != "0" {
+                if _, waiting := pending[comment.ParentID]; waiting {
+                    continue
+                }
+                // A reply to a comment we don't have becomes a top-level comment
+                if local, ok := run.comments[comment.ParentID]; ok {
+                    parentID = &local
+                }
+            }
+            delete(pending, id)
+            progress = true
+
+            name := strings.TrimSpace(comment.Author)
+            if name == "" {
+                name = "Anonymous"
+            }
+            text := utils.StripHTML(comment.Content)
+            if text == "" {
+                run.report.Comments.Skipped++
+                continue
+            }
+
+            local := &models.Comment{
+                BlogPostID: postID,
+                Name:       name,
+                Email:      comment.AuthorEmail,
+                Text:       text,
+                ParentID:   parentID,
+                CreatedAt:  comment.Created(),
+            }
+            if err := s.importRepo.CreateComment(local, run.source, comment.ID); err != nil {
+                run.warn("comment %s on post %s: %v", comment.ID, item.PostID, err)
+                run.report.Comments.Failed++
+                continue
+            }
+            run.comments[comment.ID] = local.ID
+            run.report.Comments.Created++
+        }
+
+        // Only a reply loop is left, which a real export doesn't have
+        if !progress {
+            for _, id := range order {
+                if _, ok := pending[id]; ok {
+                    run.warn("comment %s on post %s: reply loop, skipped", id, item.PostID)
+                    run.report.Comments.Failed++
+                }
+            }
+            return
+        }
+    }
+}
@@ -0,0 +1,183 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/utils"
+    "fmt"
+    "strconv"
+    "time"
+)
+
+// Longest description we hand to link previews, most networks cut around here
+const shareDescriptionLength = 200
+
+// Open Graph wants a territory with the language
+var ogLocales = map[string]string{
+    "bn": "bn_BD",
+    "en": "en_US",
+    "fi": "fi_FI",
+}
+
+type MetaServiceInterface interface {
+    GetPostMeta(id uint, opts models.ReadOptions) (*models.PostMetaResponse, error)
+}
+
+type MetaService struct {
+    blogRepo repositories.BlogRepositoryInterface
+}
+
+func NewMetaService(blogRepo repositories.BlogRepositoryInterface) MetaServiceInterface {
+    return &MetaService{
+        blogRepo: blogRepo,
+    }
+}
+
+type shareImage struct {
+    url           string
+    width, height int // 0 when unknown
+}
+
+// pickShareImage picks the preview image: the override, then the post's own image
+// (the hero JPEG when there is one, every network reads JPEG), then the site default
+func pickShareImage(post models.BlogPost) shareImage {
+    if post.ShareImage != "" {
+        return shareImage{url: post.ShareImage}
+    }
+    if post.ImageMedia != nil {
+        for _, variant := range post.ImageMedia.Variants {
+            if variant.Name == "hero" && variant.Format == "jpeg" {
+                return shareImage{url: variant.URL, width: variant.Width, height: variant.Height}
+            }
+        }
+        return shareImage{url: post.ImageMedia.URL, width: post.ImageMedia.Width, height: post.ImageMedia.Height}
+    }
+    if post.Image != "" {
+        return shareImage{url: post.Image}
+    }
+    return shareImage{url: config.DefaultShareImage()}
+}
+
+// GetPostMeta builds share metadata for a published post, in the reader's language
+// when a translation exists. Overrides only apply to the canonical language.
+func (s *MetaService) GetPostMeta(id uint, opts models.ReadOptions) (*models.PostMetaResponse, error) {
+    post, err := s.blogRepo.GetByID(id)
+    if err != nil || !post.Published {
+        return nil, ErrPostNotFound
+    }
+
+    canonicalLang := post.Language
+    localized, languages := localize(*post, opts.Language)
+    url := config.LocalizedPostURL(post.ID, localized.Language, canonicalLang)
+
+    title := localized.Title
+    description := localized.Excerpt
+    if description == "" {
+        description = utils.StripHTML(localized.Content)
+    }
+    if localized.Language == canonicalLang {
+        if post.ShareTitle != "" {
+            title = post.ShareTitle
+        }
+        if post.ShareDescription != "" {
+            description = post.ShareDescription
+        }
+    }
+    description = utils.Truncate(description, shareDescriptionLength)
+
+    image := pickShareImage(*post)
+    published := publishedAt(*post).UTC().Format(time.RFC3339)
+    modified := post.UpdatedAt.UTC().Format(time.RFC3339)
+    authors := toAuthorResponses(post.Authors)
+    tags := tagNames(*post)
+
+    og := []models.MetaTag{
+        {Key: "og:type", Content: "article"},
+        {Key: "og:site_name", Content: config.SiteTitle()},
+        {Key: "og:title", Content: title},
+        {Key: "og:description", Content: description},
+        {Key: "og:url", Content: url},
+    }
+    if locale, ok := ogLocales[localized.Language]; ok {
+        og = append(og, models.MetaTag{Key: "og:locale", Content: locale})
+    }
+    for _, lang := range languages {
+        if locale, ok := ogLocales[lang]; ok && lang != localized.Language {
+            og = append(og, models.MetaTag{Key: "og:locale:alternate", Content: locale})
+        }
+    }
+    if image.url != "" {
+        og = append(og, models.MetaTag{Key: "og:image", Content: image.url})
+        if image.width > 0 && image.height > 0 {
+            og = append(og,
+                models.MetaTag{Key: "og:image:width", Content: strconv.Itoa(image.width)},
+                models.MetaTag{Key: "og:image:height", Content: strconv.Itoa(image.height)},
+            )
+        }
+        og = append(og, models.MetaTag{Key: "og:image:alt", Content: title})
+    }
+    og = append(og,
+        models.MetaTag{Key: "article:published_time", Content: published},
+        models.MetaTag{Key: "article:modified_time", Content: modified},
+    )
+    for _, author := range authors {
+        og = append(og, models.MetaTag{Key: "article:author", Content: author.ProfileURL})
+    }
+    for _, tag := range tags {
+        og = append(og, models.MetaTag{Key: "article:tag", Content: tag})
+    }
+
+    card := "summary"
+    if image.url != "" {
+        card = "summary_large_image"
+    }
+    twitter := []models.MetaTag{
+        {Key: "twitter:card", Content: card},
+        {Key: "twitter:title", Content: title},
+        {Key: "twitter:description", Content: description},
+    }
+    if image.url != "" {
+        twitter = append(twitter, models.MetaTag{Key: "twitter:image", Content: image.url})
+    }
+    if site := config.TwitterSite(); site != "" {
+        twitter = append(twitter, models.MetaTag{Key: "twitter:site", Content: site})
+    }
+
+    posting := models.BlogPosting{
+        Context:          "https://schema.org",
+        Type:             "BlogPosting",
+        Headline:         title,
+        Description:      description,
+        DatePublished:    published,
+        DateModified:     modified,
+        InLanguage:       localized.Language,
+        Keywords:         tags,
+        MainEntityOfPage: url,
+        Publisher: models.SchemaPublisher{
+            Type: "Organization",
+            Name: config.SiteTitle(),
+            URL:  config.FrontendURL(),
+        },
+    }
+    if image.url != "" {
+        posting.Image = []string{image.url}
+    }
+    for _, author := range authors {
+        posting.Author = append(posting.Author, models.SchemaPerson{Type: "Person", Name: author.Name, URL: author.ProfileURL})
+    }
+    if len(posting.Author) == 0 && post.Author != "" {
+        posting.Author = []models.SchemaPerson{{Type: "Person", Name: post.Author}}
+    }
+
+    return &models.PostMetaResponse{
+        Title:       fmt.Sprintf("%s | %s", title, config.SiteTitle()),
+        Description: description,
+        URL:         url,
+        Image:       image.url,
+        Language:    localized.Language,
+        OpenGraph:   og,
+        Twitter:     twitter,
+        JSONLD:      posting,
+    }, nil
+}
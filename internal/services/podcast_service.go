@@ -0,0 +1,176 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/feeds"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "errors"
+    "fmt"
+    "net/mail"
+    "time"
+)
+
+type PodcastServiceInterface interface {
+    GetSettings() (*models.PodcastSettings, error)
+    UpdateSettings(req models.UpdatePodcastSettingsRequest) (*models.PodcastSettings, error)
+    BuildFeed() ([]byte, time.Time, error)
+}
+
+type PodcastService struct {
+    blogRepo    repositories.BlogRepositoryInterface
+    podcastRepo repositories.PodcastRepositoryInterface
+}
+
+func NewPodcastService(blogRepo repositories.BlogRepositoryInterface, podcastRepo repositories.PodcastRepositoryInterface) PodcastServiceInterface {
+    return &PodcastService{
+        blogRepo:    blogRepo,
+        podcastRepo: podcastRepo,
+    }
+}
+
+func (s *PodcastService) GetSettings() (*models.PodcastSettings, error) {
+    return s.podcastRepo.GetSettings()
+}
+
+func (s *PodcastService) UpdateSettings(req models.UpdatePodcastSettingsRequest) (*models.PodcastSettings, error) {
+    settings, err := s.podcastRepo.GetSettings()
+    if err != nil {
+        return nil, err
+    }
+
+    if req.Type != nil && *req.Type != "episodic" && *req.Type != "serial" {
+        return nil, errors.New("type must be episodic or serial")
+    }
+    if req.OwnerEmail != nil && *req.OwnerEmail != "" {
+        if _, err := mail.ParseAddress(*req.OwnerEmail); err != nil {
+            return nil, errors.New("owner email is not valid")
+        }
+    }
+
+    setString := func(dst *string, value *string) {
+        if value != nil {
+            *dst = *value
+        }
+    }
+    setString(&settings.Title, req.Title)
+    setString(&settings.Description, req.Description)
+    setString(&settings.Author, req.Author)
+    setString(&settings.OwnerName, req.OwnerName)
+    setString(&settings.OwnerEmail, req.OwnerEmail)
+    setString(&settings.ImageURL, req.ImageURL)
+    setString(&settings.Language, req.Language)
+    setString(&settings.Category, req.Category)
+    setString(&settings.Subcategory, req.Subcategory)
+    setString(&settings.Type, req.Type)
+    setString(&settings.Copyright, req.Copyright)
+    setString(&settings.GUID, req.GUID)
+    if req.Explicit != nil {
+        settings.Explicit = *req.Explicit
+    }
+    if req.Locked != nil {
+        settings.Locked = *req.Locked
+    }
+
+    if err := s.podcastRepo.SaveSettings(settings); err != nil {
+        return nil, err
+    }
+    return settings, nil
+}
+
+// BuildFeed renders the podcast RSS and returns when it last changed, for Last-Modified
+func (s *PodcastService) BuildFeed() ([]byte, time.Time, error) {
+    settings, err := s.podcastRepo.GetSettings()
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+    posts, err := s.blogRepo.GetWithAudio()
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+
+    feedURL := config.PublicBaseURL() + "/feeds/podcast.xml"
+    lastModified := settings.UpdatedAt
+
+    channel := feeds.PodcastChannel{
+        Title:          settings.Title,
+        Link:           config.FrontendURL(),
+        AtomLink:       feeds.AtomLink{Href: feedURL, Rel: "self", Type: "application/rss+xml"},
+        Description:    settings.Description,
+        Language:       settings.Language,
+        Copyright:      settings.Copyright,
+        ItunesAuthor:   settings.Author,
+        ItunesExplicit: feeds.ExplicitValue(settings.Explicit),
+        ItunesType:     settings.Type,
+        PodcastGUID:    settings.GUID,
+        Items:          []feeds.PodcastItem{},
+    }
+    if channel.PodcastGUID == "" {
+        channel.PodcastGUID = feeds.PodcastGUID(feedURL)
+    }
+    if settings.OwnerName != "" || settings.OwnerEmail != "" {
+        channel.ItunesOwner = &feeds.ItunesOwner{Name: settings.OwnerName, Email: settings.OwnerEmail}
+    }
+    if settings.ImageURL != "" {
+        channel.ItunesImage = &feeds.ItunesImage{Href: settings.ImageURL}
+    }
+    if settings.Category != "" {
+        channel.ItunesCategory = &feeds.ItunesCategory{Text: settings.Category}
+        if settings.Subcategory != "" {
+            channel.ItunesCategory.Subcategory = &feeds.ItunesCategory{Text: settings.Subcategory}
+        }
+    }
+    locked := "no"
+    if settings.Locked {
+        locked = "yes"
+    }
+    channel.PodcastLocked = &feeds.PodcastLocked{Owner: settings.OwnerEmail, Value: locked}
+
+    for _, post := range posts {
+        if post.Audio == nil {
+            continue
+        }
+        audio := toAudioResponse(*post.Audio)
+
+        item := feeds.PodcastItem{
+            Title:       post.Title,
+            Link:        config.PostURL(post.ID),
+            Description: post.Excerpt,
+            GUID:        feeds.GUID{Value: fmt.Sprintf("finbangla-voice-post-%d", post.ID)},
+            PubDate:     post.CreatedAt.Format(time.RFC1123Z),
+            Enclosure: feeds.Enclosure{
+                URL:    audio.URL,
+                Length: audio.Size,
+                Type:   audio.ContentType,
+            },
+            ItunesTitle:       post.Title,
+            ItunesAuthor:      post.Author,
+            ItunesDuration:    int(post.Audio.Duration + 0.5),
+            ItunesExplicit:    feeds.ExplicitValue(post.Audio.Explicit),
+            ItunesEpisode:     post.Audio.Episode,
+            ItunesSeason:      post.Audio.Season,
+            ItunesEpisodeType: post.Audio.EpisodeType,
+            PodcastEpisode:    post.Audio.Episode,
+            PodcastSeason:     post.Audio.Season,
+        }
+        if post.Image != "" {
+            item.ItunesImage = &feeds.ItunesImage{Href: post.Image}
+        }
+        channel.Items = append(channel.Items, item)
+
+        for _, changed := range []time.Time{post.UpdatedAt, post.Audio.UpdatedAt} {
+            if changed.After(lastModified) {
+                lastModified = changed
+            }
+        }
+    }
+
+    channel.LastBuildDate = lastModified.Format(time.RFC1123Z)
+    body, err := feeds.Marshal(feeds.NewPodcastRSS(channel))
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+    // Episodes disappearing doesn't show in the dates above
+    lastModified, err = siteLastModified(s.blogRepo, lastModified)
+    return body, lastModified, err
+}
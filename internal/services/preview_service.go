@@ -0,0 +1,91 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/utils"
+    "errors"
+    "time"
+)
+
+var ErrInvalidPreview = errors.New("preview link is invalid, expired or revoked")
+
+// Longest a preview link can be made to last
+const previewMaxTTL = 30 * 24 * time.Hour
+
+type PreviewServiceInterface interface {
+    CreatePreviewLink(postID, userID uint, req models.CreatePreviewLinkRequest) (*models.PreviewLinkResponse, error)
+    RevokePreviewLinks(postID, userID uint) error
+    GetPreview(token string, opts models.ReadOptions) (*models.BlogPostResponse, error)
+}
+
+type PreviewService struct {
+    blogRepo    repositories.BlogRepositoryInterface
+    userRepo    repositories.UserRepositoryInterface
+    blogService BlogServiceInterface
+}
+
+func NewPreviewService(blogRepo repositories.BlogRepositoryInterface, userRepo repositories.UserRepositoryInterface, blogService BlogServiceInterface) PreviewServiceInterface {
+    return &PreviewService{
+        blogRepo:    blogRepo,
+        userRepo:    userRepo,
+        blogService: blogService,
+    }
+}
+
+// CreatePreviewLink signs a link to a draft that works without signing in
+func (s *PreviewService) CreatePreviewLink(postID, userID uint, req models.CreatePreviewLinkRequest) (*models.PreviewLinkResponse, error) {
+    post, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID)
+    if err != nil {
+        return nil, err
+    }
+    if post.Published {
+        return nil, errors.New("post is already published, share its public link instead")
+    }
+
+    ttl := config.PreviewTTL()
+    if req.ExpiresInHours != 0 {
+        ttl = time.Duration(req.ExpiresInHours) * time.Hour
+    }
+    if ttl <= 0 || ttl > previewMaxTTL {
+        return nil, errors.New("expires_in_hours must be between 1 and 720")
+    }
+    expiresAt := time.Now().Add(ttl)
+
+    token, err := utils.GeneratePreviewToken(utils.PreviewClaims{
+        PostID:    post.ID,
+        Version:   post.PreviewVersion,
+        ExpiresAt: expiresAt.Unix(),
+    }, config.PreviewSecret())
+    if err != nil {
+        return nil, err
+    }
+
+    return &models.PreviewLinkResponse{
+        Token:     token,
+        URL:       config.PreviewURL(token),
+        ExpiresAt: isoTime(expiresAt),
+    }, nil
+}
+
+func (s *PreviewService) RevokePreviewLinks(postID, userID uint) error {
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID); err != nil {
+        return err
+    }
+    return s.blogRepo.RevokePreviews(postID)
+}
+
+// GetPreview returns the post behind a valid preview link, draft or not
+func (s *PreviewService) GetPreview(token string, opts models.ReadOptions) (*models.BlogPostResponse, error) {
+    claims, err := utils.ParsePreviewToken(token, config.PreviewSecret())
+    if err != nil {
+        return nil, ErrInvalidPreview
+    }
+
+    post, err := s.blogRepo.GetByID(claims.PostID)
+    if err != nil || post.PreviewVersion != claims.Version {
+        return nil, ErrInvalidPreview
+    }
+    return s.blogService.GetDraft(post.ID, opts)
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "log"
+    "strings"
+)
+
+var (
+    ErrUnknownReaction = errors.New("unknown reaction")
+    ErrReaderRequired  = errors.New("sign in or send a reader fingerprint")
+)
+
+// Fingerprints are random IDs the frontend keeps in local storage, anything
+// much longer isn't one
+const maxFingerprintLength = 128
+
+type ReactionServiceInterface interface {
+    GetReactions(postID uint, userID *uint, fingerprint string) (*models.ReactionsResponse, error)
+    AddReaction(postID uint, reaction string, userID *uint, fingerprint string) (*models.ReactionsResponse, error)
+    RemoveReaction(postID uint, reaction string, userID *uint, fingerprint string) (*models.ReactionsResponse, error)
+}
+
+type ReactionService struct {
+    blogRepo     repositories.BlogRepositoryInterface
+    reactionRepo repositories.ReactionRepositoryInterface
+}
+
+func NewReactionService(blogRepo repositories.BlogRepositoryInterface, reactionRepo repositories.ReactionRepositoryInterface) ReactionServiceInterface {
+    return &ReactionService{
+        blogRepo:     blogRepo,
+        reactionRepo: reactionRepo,
+    }
+}
+
+// readerKey identifies who is reacting: the account when signed in, otherwise
+// a keyed hash of the anonymous fingerprint. "" when neither is known.
+func readerKey(userID *uint, fingerprint string) string {
+    if userID != nil {
+        return fmt.Sprintf("user:%d", *userID)
+    }
+
+    fingerprint = strings.TrimSpace(fingerprint)
+    if fingerprint == "" || len(fingerprint) > maxFingerprintLength {
+        return ""
+    }
+    mac := hmac.New(sha256.New, []byte(config.FingerprintSecret()))
+    mac.Write([]byte(fingerprint))
+    return "anon:" + hex.EncodeToString(mac.Sum(nil)[:24])
+}
+
+// countReactions returns reaction counts per post. Counts are decoration, so
+// failures are logged and the posts show zeros rather than failing to load.
+func countReactions(reactionRepo repositories.ReactionRepositoryInterface, postIDs []uint) map[uint]map[string]int64 {
+    byPost := map[uint]map[string]int64{}
+    counts, err := reactionRepo.CountByPosts(postIDs)
+    if err != nil {
+        log.Printf("⚠️ Failed to count reactions: %v", err)
+        return byPost
+    }
+
+    for _, count := range counts {
+        if byPost[count.BlogPostID] == nil {
+            byPost[count.BlogPostID] = map[string]int64{}
+        }
+        byPost[count.BlogPostID][count.Reaction] = count.Count
+    }
+    return byPost
+}
+
+// Every configured reaction with its count. Reactions no longer configured are left out.
+func reactionCounts(counts map[string]int64) map[string]int64 {
+    result := map[string]int64{}
+    for _, reaction := range config.Reactions() {
+        result[reaction] = counts[reaction]
+    }
+    return result
+}
+
+func (s *ReactionService) GetReactions(postID uint, userID *uint, fingerprint string) (*models.ReactionsResponse, error) {
+    published, err := s.blogRepo.IsPublished(postID)
+    if err != nil {
+        return nil, err
+    }
+    if !published {
+        return nil, ErrPostNotFound
+    }
+
+    return s.buildResponse(postID, readerKey(userID, fingerprint))
+}
+
+// AddReaction is idempotent, adding the same reaction twice leaves one
+func (s *ReactionService) AddReaction(postID uint, reaction string, userID *uint, fingerprint string) (*models.ReactionsResponse, error) {
+    if !config.IsReaction(reaction) {
+        return nil, ErrUnknownReaction
+    }
+    reader := readerKey(userID, fingerprint)
+    if reader == "" {
+        return nil, ErrReaderRequired
+    }
+
+    published, err := s.blogRepo.IsPublished(postID)
+    if err != nil {
+        return nil, err
+    }
+    if !published {
+        return nil, ErrPostNotFound
+    }
+
+    err = s.reactionRepo.Set(&models.PostReaction{
+        BlogPostID: postID,
+        Reader:     reader,
+        UserID:     userID,
+        Reaction:   reaction,
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return s.buildResponse(postID, reader)
+}
+
+// RemoveReaction is idempotent, removing a reaction that isn't there succeeds
+func (s *ReactionService) RemoveReaction(postID uint, reaction string, userID *uint, fingerprint string) (*models.ReactionsResponse, error) {
+    if !config.IsReaction(reaction) {
+        return nil, ErrUnknownReaction
+    }
+    reader := readerKey(userID, fingerprint)
+    if reader == "" {
+        return nil, ErrReaderRequired
+    }
+
+    if err := s.reactionRepo.Remove(postID, reader, reaction); err != nil {
+        return nil, err
+    }
+
+    return s.buildResponse(postID, reader)
+}
+
+func (s *ReactionService) buildResponse(postID uint, reader string) (*models.ReactionsResponse, error) {
+    counts, err := s.reactionRepo.CountByPosts([]uint{postID})
+    if err != nil {
+        return nil, err
+    }
+
+    byReaction := map[string]int64{}
+    for _, count := range counts {
+        byReaction[count.Reaction] = count.Count
+    }
+
+    response := &models.ReactionsResponse{
+        Counts:    reactionCounts(byReaction),
+        Available: config.Reactions(),
+    }
+    if reader != "" {
+        if mine, err := s.reactionRepo.GetByReader(postID, reader); err == nil && config.IsReaction(mine.Reaction) {
+            response.Mine = mine.Reaction
+        }
+    }
+    return response, nil
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+)
+
+type RelatedServiceInterface interface {
+    GetRelated(id uint, limit int, opts models.ReadOptions) ([]models.BlogPostResponse, error)
+}
+
+type RelatedService struct {
+    blogRepo    repositories.BlogRepositoryInterface
+    related     *search.RelatedIndex
+    blogService BlogServiceInterface
+}
+
+func NewRelatedService(blogRepo repositories.BlogRepositoryInterface, related *search.RelatedIndex, blogService BlogServiceInterface) RelatedServiceInterface {
+    return &RelatedService{
+        blogRepo:    blogRepo,
+        related:     related,
+        blogService: blogService,
+    }
+}
+
+// GetRelated recommends published posts similar to a published post, best match first
+func (s *RelatedService) GetRelated(id uint, limit int, opts models.ReadOptions) ([]models.BlogPostResponse, error) {
+    published, err := s.blogRepo.IsPublished(id)
+    if err != nil {
+        return nil, err
+    }
+    if !published {
+        return nil, ErrPostNotFound
+    }
+
+    ids, err := s.related.Related(id, limit)
+    if err != nil {
+        return nil, err
+    }
+    return s.blogService.GetPublishedPostsByIDs(ids, opts)
+}
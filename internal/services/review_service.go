@@ -0,0 +1,401 @@
+package services
+
+import (
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "errors"
+    "log"
+    "time"
+)
+
+var (
+    ErrReviewForbidden     = errors.New("you can't do this on this post")
+    ErrPostForbidden       = errors.New("only the post's authors and editors can do this")
+    ErrReviewNoteNotFound  = errors.New("review note not found")
+    ErrNeedsApproval       = errors.New("post must be approved by an editor before it can be published")
+    ErrInvalidReviewStatus = errors.New("status must be draft, submitted, in_review, changes_requested or approved")
+)
+
+// Who may move a post into each review state, and from where
+type reviewTransition struct {
+    from      []string
+    staffOnly bool
+}
+
+var reviewTransitions = map[string]reviewTransition{
+    models.ReviewDraft:            {from: []string{models.ReviewSubmitted, models.ReviewChangesRequested, models.ReviewApproved}},
+    models.ReviewSubmitted:        {from: []string{"", models.ReviewDraft, models.ReviewChangesRequested}},
+    models.ReviewInReview:         {from: []string{models.ReviewSubmitted}, staffOnly: true},
+    models.ReviewChangesRequested: {from: []string{models.ReviewSubmitted, models.ReviewInReview}, staffOnly: true},
+    models.ReviewApproved:         {from: []string{models.ReviewSubmitted, models.ReviewInReview}, staffOnly: true},
+}
+
+// States that show up in the editors' queue
+var reviewQueueStatuses = []string{models.ReviewSubmitted, models.ReviewInReview}
+
+// mayPublish reports whether the user can put the post live: editors
+// always, its authors only once an editor approved it
+func mayPublish(post *models.BlogPost, user *models.User) bool {
+    return isStaff(user) || (post.ReviewStatus == models.ReviewApproved && isPostAuthor(post, user.ID))
+}
+
+func isStaff(user *models.User) bool {
+    return user.Role == models.RoleEditor || user.Role == models.RoleAdmin
+}
+
+func isPostAuthor(post *models.BlogPost, userID uint) bool {
+    if post.AuthorID != nil && *post.AuthorID == userID {
+        return true
+    }
+    for _, author := range post.Authors {
+        if author.UserID == userID {
+            return true
+        }
+    }
+    return false
+}
+
+// editablePost loads the post and the caller, who must be one of its authors or an editor.
+// Legacy posts that database.BackfillPostAuthors could not match to an account
+// have no authors, so only editors can edit them until one is assigned with
+// the change_author bulk action.
+func editablePost(blogRepo repositories.BlogRepositoryInterface, userRepo repositories.UserRepositoryInterface, postID, userID uint) (*models.BlogPost, *models.User, error) {
+    post, err := blogRepo.GetByID(postID)
+    if err != nil {
+        return nil, nil, ErrPostNotFound
+    }
+    user, err := userRepo.GetByID(userID)
+    if err != nil {
+        return nil, nil, ErrPostForbidden
+    }
+    if !isStaff(user) && !isPostAuthor(post, userID) {
+        return nil, nil, ErrPostForbidden
+    }
+    return post, user, nil
+}
+
+type ReviewServiceInterface interface {
+    GetReview(postID, userID uint) (*models.ReviewResponse, error)
+    Transition(postID, userID uint, req models.ReviewTransitionRequest) (*models.ReviewResponse, error)
+    AssignReviewers(postID, userID uint, req models.AssignReviewersRequest) (*models.ReviewResponse, error)
+    GetQueue(userID uint, assignedToMe bool) ([]models.ReviewQueueItem, error)
+    AddNote(postID, userID uint, req models.CreateReviewNoteRequest) (*models.ReviewNoteResponse, error)
+    ResolveNote(noteID, userID uint, resolved bool) (*models.ReviewNoteResponse, error)
+    DeleteNote(noteID, userID uint) error
+}
+
+type ReviewService struct {
+    reviewRepo repositories.ReviewRepositoryInterface
+    blogRepo   repositories.BlogRepositoryInterface
+    userRepo   repositories.UserRepositoryInterface
+}
+
+func NewReviewService(reviewRepo repositories.ReviewRepositoryInterface, blogRepo repositories.BlogRepositoryInterface, userRepo repositories.UserRepositoryInterface) ReviewServiceInterface {
+    return &ReviewService{
+        reviewRepo: reviewRepo,
+        blogRepo:   blogRepo,
+        userRepo:   userRepo,
+    }
+}
+
+// Load the post and the caller, who must be one of its authors or an editor
+func (s *ReviewService) access(postID, userID uint) (*models.BlogPost, *models.User, error) {
+    return editablePost(s.blogRepo, s.userRepo, postID, userID)
+}
+
+func toReviewNoteResponse(note models.ReviewNote) models.ReviewNoteResponse {
+    response := models.ReviewNoteResponse{
+        ID:        note.ID,
+        Body:      note.Body,
+        Author:    authorResponse(note.Author),
+        Resolved:  note.Resolved,
+        CreatedAt: isoTime(note.CreatedAt),
+    }
+    if note.AnchorStart != nil && note.AnchorEnd != nil {
+        response.Anchor = &models.ReviewAnchor{Start: *note.AnchorStart, End: *note.AnchorEnd, Quote: note.Quote}
+    }
+    if note.ResolvedAt != nil {
+        response.ResolvedAt = isoTime(*note.ResolvedAt)
+    }
+    return response
+}
+
+func reviewerResponses(reviewers []models.PostReviewer) []models.AuthorResponse {
+    responses := []models.AuthorResponse{}
+    for _, reviewer := range reviewers {
+        responses = append(responses, authorResponse(reviewer.User))
+    }
+    return responses
+}
+
+func (s *ReviewService) buildReview(post *models.BlogPost) (*models.ReviewResponse, error) {
+    reviewers, err := s.reviewRepo.GetReviewers(post.ID)
+    if err != nil {
+        return nil, err
+    }
+    notes, err := s.reviewRepo.GetNotes(post.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    response := &models.ReviewResponse{
+        PostID:    post.ID,
+        Title:     post.Title,
+        Status:    post.ReviewStatus,
+        Published: post.Published,
+        Reviewers: reviewerResponses(reviewers),
+        Notes:     []models.ReviewNoteResponse{},
+    }
+    for _, note := range notes {
+        response.Notes = append(response.Notes, toReviewNoteResponse(note))
+    }
+    return response, nil
+}
+
+func (s *ReviewService) GetReview(postID, userID uint) (*models.ReviewResponse, error) {
+    post, _, err := s.access(postID, userID)
+    if err != nil {
+        return nil, err
+    }
+    return s.buildReview(post)
+}
+
+// Transition moves a post through the workflow. Authors submit and
+// withdraw, editors start reviews, request changes and approve.
+func (s *ReviewService) Transition(postID, userID uint, req models.ReviewTransitionRequest) (*models.ReviewResponse, error) {
+    rule, ok := reviewTransitions[req.Status]
+    if !ok {
+        return nil, ErrInvalidReviewStatus
+    }
+
+    post, user, err := s.access(postID, userID)
+    if err != nil {
+        return nil, err
+    }
+    if rule.staffOnly && !isStaff(user) {
+        return nil, ErrReviewForbidden
+    }
+    if post.Published {
+        return nil, errors.New("post is published, unpublish it before sending it through review")
+    }
+
+    allowed := false
+    for _, from := range rule.from {
+        if post.ReviewStatus == from {
+            allowed = true
+        }
+    }
+    if !allowed {
+        current := post.ReviewStatus
+        if current == "" {
+            current = "not under review"
+        }
+        return nil, errors.New("post can't move from " + current + " to " + req.Status)
+    }
+
+    reviewers, err := s.reviewRepo.GetReviewers(post.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    // Once reviewers are assigned, only they (or an admin) decide
+    if rule.staffOnly && len(reviewers) > 0 && user.Role != models.RoleAdmin {
+        assigned := false
+        for _, reviewer := range reviewers {
+            if reviewer.UserID == user.ID {
+                assigned = true
+            }
+        }
+        if !assigned {
+            return nil, ErrReviewForbidden
+        }
+    }
+
+    // Whoever picks up an unassigned post becomes its reviewer
+    if req.Status == models.ReviewInReview && len(reviewers) == 0 {
+        err := s.reviewRepo.ReplaceReviewers(post.ID, []models.PostReviewer{{
+            BlogPostID:   post.ID,
+            UserID:       user.ID,
+            AssignedByID: user.ID,
+        }})
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    if err := s.reviewRepo.SetStatus(post.ID, req.Status); err != nil {
+        return nil, err
+    }
+    post.ReviewStatus = req.Status
+
+    if req.Note != "" {
+        note := &models.ReviewNote{BlogPostID: post.ID, AuthorID: user.ID, Body: req.Note}
+        if err := s.reviewRepo.CreateNote(note); err != nil {
+            log.Printf("⚠️ Failed to save review note on post %d: %v", post.ID, err)
+        }
+    }
+
+    log.Printf("✅ Post %d moved to %s by user %d", post.ID, req.Status, user.ID)
+    return s.buildReview(post)
+}
+
+// AssignReviewers replaces the post's reviewers, who must all be editors
+func (s *ReviewService) AssignReviewers(postID, userID uint, req models.AssignReviewersRequest) (*models.ReviewResponse, error) {
+    post, err := s.blogRepo.GetByID(postID)
+    if err != nil {
+        return nil, ErrPostNotFound
+    }
+
+    ids := []uint{}
+    seen := map[uint]bool{}
+    for _, id := range req.UserIDs {
+        if !seen[id] {
+            ids = append(ids, id)
+            seen[id] = true
+        }
+    }
+
+    users, err := s.userRepo.GetByIDs(ids)
+    if err != nil {
+        return nil, err
+    }
+    byID := map[uint]*models.User{}
+    for i := range users {
+        byID[users[i].ID] = &users[i]
+    }
+
+    reviewers := []models.PostReviewer{}
+    for _, id := range ids {
+        user := byID[id]
+        if user == nil {
+            return nil, errors.New("reviewer not found")
+        }
+        if !isStaff(user) {
+            return nil, errors.New(user.Name + " is not an editor and can't review posts")
+        }
+        if isPostAuthor(post, id) {
+            return nil, errors.New(user.Name + " is an author of this post and can't review it")
+        }
+        reviewers = append(reviewers, models.PostReviewer{BlogPostID: post.ID, UserID: id, AssignedByID: userID})
+    }
+
+    if err := s.reviewRepo.ReplaceReviewers(post.ID, reviewers); err != nil {
+        return nil, err
+    }
+    return s.buildReview(post)
+}
+
+// GetQueue lists posts waiting for an editor, optionally only the caller's
+func (s *ReviewService) GetQueue(userID uint, assignedToMe bool) ([]models.ReviewQueueItem, error) {
+    var reviewerID *uint
+    if assignedToMe {
+        reviewerID = &userID
+    }
+
+    posts, err := s.reviewRepo.GetQueue(reviewQueueStatuses, reviewerID)
+    if err != nil {
+        return nil, err
+    }
+
+    ids := make([]uint, 0, len(posts))
+    for _, post := range posts {
+        ids = append(ids, post.ID)
+    }
+    reviewers, err := s.reviewRepo.GetReviewersForPosts(ids)
+    if err != nil {
+        return nil, err
+    }
+    byPost := map[uint][]models.PostReviewer{}
+    for _, reviewer := range reviewers {
+        byPost[reviewer.BlogPostID] = append(byPost[reviewer.BlogPostID], reviewer)
+    }
+    openNotes, err := s.reviewRepo.CountOpenNotes(ids)
+    if err != nil {
+        return nil, err
+    }
+
+    items := []models.ReviewQueueItem{}
+    for _, post := range posts {
+        items = append(items, models.ReviewQueueItem{
+            PostID:    post.ID,
+            Title:     post.Title,
+            Author:    post.Author,
+            Status:    post.ReviewStatus,
+            UpdatedAt: isoTime(post.UpdatedAt),
+            Reviewers: reviewerResponses(byPost[post.ID]),
+            OpenNotes: openNotes[post.ID],
+        })
+    }
+    return items, nil
+}
+
+// AddNote leaves feedback on a post, optionally anchored to a range of its
+// content. Offsets count characters, not bytes, in the content as saved.
+func (s *ReviewService) AddNote(postID, userID uint, req models.CreateReviewNoteRequest) (*models.ReviewNoteResponse, error) {
+    post, user, err := s.access(postID, userID)
+    if err != nil {
+        return nil, err
+    }
+
+    note := &models.ReviewNote{BlogPostID: post.ID, AuthorID: user.ID, Body: req.Body}
+    if (req.AnchorStart == nil) != (req.AnchorEnd == nil) {
+        return nil, errors.New("anchor_start and anchor_end must be set together")
+    }
+    if req.AnchorStart != nil {
+        content := []rune(post.Content)
+        start, end := *req.AnchorStart, *req.AnchorEnd
+        if start < 0 || end <= start || end > len(content) {
+            return nil, errors.New("anchor must be a non-empty range inside the content")
+        }
+        note.AnchorStart = &start
+        note.AnchorEnd = &end
+        note.Quote = string(content[start:end])
+    }
+
+    if err := s.reviewRepo.CreateNote(note); err != nil {
+        return nil, err
+    }
+    note.Author = *user
+
+    response := toReviewNoteResponse(*note)
+    return &response, nil
+}
+
+func (s *ReviewService) ResolveNote(noteID, userID uint, resolved bool) (*models.ReviewNoteResponse, error) {
+    note, err := s.reviewRepo.GetNote(noteID)
+    if err != nil {
+        return nil, ErrReviewNoteNotFound
+    }
+    if _, _, err := s.access(note.BlogPostID, userID); err != nil {
+        return nil, err
+    }
+
+    if resolved && !note.Resolved {
+        now := time.Now()
+        note.ResolvedAt = &now
+    } else if !resolved {
+        note.ResolvedAt = nil
+    }
+    note.Resolved = resolved
+    if err := s.reviewRepo.UpdateNote(note); err != nil {
+        return nil, err
+    }
+
+    response := toReviewNoteResponse(*note)
+    return &response, nil
+}
+
+// Only the note's writer or an admin can delete it
+func (s *ReviewService) DeleteNote(noteID, userID uint) error {
+    note, err := s.reviewRepo.GetNote(noteID)
+    if err != nil {
+        return ErrReviewNoteNotFound
+    }
+    if note.AuthorID != userID {
+        user, err := s.userRepo.GetByID(userID)
+        if err != nil || user.Role != models.RoleAdmin {
+            return ErrReviewForbidden
+        }
+    }
+    return s.reviewRepo.DeleteNote(noteID)
+}
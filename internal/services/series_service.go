@@ -0,0 +1,227 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/utils"
+    "errors"
+    "fmt"
+    "strings"
+)
+
+var ErrSeriesNotFound = errors.New("series not found")
+
+type SeriesServiceInterface interface {
+    GetAllSeries() ([]models.SeriesResponse, error)
+    GetSeries(slug string, opts models.ReadOptions) (*models.SeriesResponse, error)
+    CreateSeries(req models.SaveSeriesRequest) (*models.SeriesResponse, error)
+    UpdateSeries(id uint, req models.SaveSeriesRequest) (*models.SeriesResponse, error)
+    DeleteSeries(id uint) error
+    SetSeriesPosts(id uint, req models.SetSeriesPostsRequest) (*models.SeriesResponse, error)
+}
+
+type SeriesService struct {
+    seriesRepo  repositories.SeriesRepositoryInterface
+    blogRepo    repositories.BlogRepositoryInterface
+    blogService BlogServiceInterface
+}
+
+func NewSeriesService(seriesRepo repositories.SeriesRepositoryInterface, blogRepo repositories.BlogRepositoryInterface, blogService BlogServiceInterface) SeriesServiceInterface {
+    return &SeriesService{
+        seriesRepo:  seriesRepo,
+        blogRepo:    blogRepo,
+        blogService: blogService,
+    }
+}
+
+// Readers only see published parts, numbered without gaps
+func publishedParts(series models.Series) []models.SeriesPost {
+    parts := []models.SeriesPost{}
+    for _, part := range series.Posts {
+        if part.BlogPost.Published {
+            parts = append(parts, part)
+        }
+    }
+    return parts
+}
+
+func toSeriesResponse(series models.Series) *models.SeriesResponse {
+    return &models.SeriesResponse{
+        ID:          series.ID,
+        Title:       series.Title,
+        Slug:        series.Slug,
+        Description: series.Description,
+        URL:         config.SeriesURL(series.Slug),
+        PostCount:   len(publishedParts(series)),
+        CreatedAt:   isoTime(series.CreatedAt),
+        UpdatedAt:   isoTime(series.UpdatedAt),
+    }
+}
+
+// seriesNavigation places the post among the series' published parts. A draft
+// still gets its place so authors can preview the links.
+func seriesNavigation(series models.Series, postID uint, lang string) *models.SeriesNavigation {
+    parts := []models.SeriesPost{}
+    current := -1
+    for _, part := range series.Posts {
+        if part.BlogPostID == postID {
+            current = len(parts)
+        } else if !part.BlogPost.Published {
+            continue
+        }
+        parts = append(parts, part)
+    }
+    if current < 0 {
+        return nil
+    }
+
+    link := func(i int) *models.SeriesLink {
+        if i < 0 || i >= len(parts) {
+            return nil
+        }
+        post, _ := localize(parts[i].BlogPost, lang)
+        return &models.SeriesLink{
+            ID:    fmt.Sprintf("%d", post.ID),
+            Title: post.Title,
+            Part:  i + 1,
+            URL:   config.LocalizedPostURL(post.ID, post.Language, parts[i].BlogPost.Language),
+        }
+    }
+
+    return &models.SeriesNavigation{
+        ID:       series.ID,
+        Title:    series.Title,
+        Slug:     series.Slug,
+        URL:      config.SeriesURL(series.Slug),
+        Part:     current + 1,
+        Total:    len(parts),
+        Previous: link(current - 1),
+        Next:     link(current + 1),
+    }
+}
+
+func (s *SeriesService) GetAllSeries() ([]models.SeriesResponse, error) {
+    series, err := s.seriesRepo.GetAll()
+    if err != nil {
+        return nil, err
+    }
+
+    responses := []models.SeriesResponse{}
+    for _, item := range series {
+        responses = append(responses, *toSeriesResponse(item))
+    }
+    return responses, nil
+}
+
+// GetSeries is the landing page data: the series and its published parts in order
+func (s *SeriesService) GetSeries(slug string, opts models.ReadOptions) (*models.SeriesResponse, error) {
+    series, err := s.seriesRepo.GetBySlug(slug)
+    if err != nil {
+        return nil, ErrSeriesNotFound
+    }
+
+    ids := []uint{}
+    for _, part := range publishedParts(*series) {
+        ids = append(ids, part.BlogPostID)
+    }
+    posts, err := s.blogService.GetPublishedPostsByIDs(ids, opts)
+    if err != nil {
+        return nil, err
+    }
+
+    response := toSeriesResponse(*series)
+    response.Posts = posts
+    return response, nil
+}
+
+// Apply a save request, the slug defaults to one made from the title
+func (s *SeriesService) applySeriesRequest(series *models.Series, req models.SaveSeriesRequest) error {
+    title := strings.TrimSpace(req.Title)
+    if title == "" {
+        return errors.New("title is required")
+    }
+
+    slug := utils.Slugify(req.Slug)
+    if slug == "" {
+        slug = utils.Slugify(title)
+    }
+    if slug == "" {
+        return errors.New("slug is required")
+    }
+    if existing, err := s.seriesRepo.GetBySlug(slug); err == nil && existing.ID != series.ID {
+        return errors.New("another series already uses that slug")
+    }
+
+    series.Title = title
+    series.Slug = slug
+    series.Description = strings.TrimSpace(req.Description)
+    return nil
+}
+
+func (s *SeriesService) CreateSeries(req models.SaveSeriesRequest) (*models.SeriesResponse, error) {
+    series := &models.Series{}
+    if err := s.applySeriesRequest(series, req); err != nil {
+        return nil, err
+    }
+
+    if err := s.seriesRepo.Create(series); err != nil {
+        return nil, err
+    }
+    return toSeriesResponse(*series), nil
+}
+
+func (s *SeriesService) UpdateSeries(id uint, req models.SaveSeriesRequest) (*models.SeriesResponse, error) {
+    series, err := s.seriesRepo.GetByID(id)
+    if err != nil {
+        return nil, ErrSeriesNotFound
+    }
+    if err := s.applySeriesRequest(series, req); err != nil {
+        return nil, err
+    }
+
+    if err := s.seriesRepo.Update(series); err != nil {
+        return nil, err
+    }
+    return toSeriesResponse(*series), nil
+}
+
+// DeleteSeries keeps the posts, they just stop being parts of a series
+func (s *SeriesService) DeleteSeries(id uint) error {
+    if _, err := s.seriesRepo.GetByID(id); err != nil {
+        return ErrSeriesNotFound
+    }
+    return s.seriesRepo.Delete(id)
+}
+
+// SetSeriesPosts replaces the parts, in order. A post already in another series moves here.
+func (s *SeriesService) SetSeriesPosts(id uint, req models.SetSeriesPostsRequest) (*models.SeriesResponse, error) {
+    if _, err := s.seriesRepo.GetByID(id); err != nil {
+        return nil, ErrSeriesNotFound
+    }
+
+    seen := map[uint]bool{}
+    for _, postID := range req.PostIDs {
+        if seen[postID] {
+            return nil, errors.New("a post can only appear once in a series")
+        }
+        seen[postID] = true
+    }
+    posts, err := s.blogRepo.GetByIDs(req.PostIDs)
+    if err != nil {
+        return nil, err
+    }
+    if len(posts) != len(req.PostIDs) {
+        return nil, ErrPostNotFound
+    }
+
+    if err := s.seriesRepo.ReplacePosts(id, req.PostIDs); err != nil {
+        return nil, err
+    }
+
+    series, err := s.seriesRepo.GetByID(id)
+    if err != nil {
+        return nil, err
+    }
+    return toSeriesResponse(*series), nil
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/feeds"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "errors"
+    "fmt"
+    "strings"
+    "time"
+)
+
+var ErrSitemapNotFound = errors.New("sitemap not found")
+
+type SitemapServiceInterface interface {
+    BuildSitemap(page int) ([]byte, time.Time, error)
+    RobotsTxt() string
+}
+
+type SitemapService struct {
+    blogRepo        repositories.BlogRepositoryInterface
+    translationRepo repositories.TranslationRepositoryInterface
+}
+
+func NewSitemapService(blogRepo repositories.BlogRepositoryInterface, translationRepo repositories.TranslationRepositoryInterface) SitemapServiceInterface {
+    return &SitemapService{
+        blogRepo:        blogRepo,
+        translationRepo: translationRepo,
+    }
+}
+
+// One sitemap URL and when its page last changed
+type sitemapItem struct {
+    url     feeds.SitemapURL
+    updated time.Time
+}
+
+// BuildSitemap renders /sitemap.xml when page is 0, or one numbered page of posts.
+// Up to SitemapMaxURLs URLs /sitemap.xml is a plain urlset, beyond that it becomes
+// an index pointing at /sitemaps/posts-N.xml.
+func (s *SitemapService) BuildSitemap(page int) ([]byte, time.Time, error) {
+    items, updated, err := s.sitemapItems()
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+
+    var pages [][]sitemapItem
+    for start := 0; start < len(items); start += feeds.SitemapMaxURLs {
+        end := start + feeds.SitemapMaxURLs
+        if end > len(items) {
+            end = len(items)
+        }
+        pages = append(pages, items[start:end])
+    }
+
+    if page == 0 && len(pages) > 1 {
+        entries := make([]feeds.SitemapEntry, len(pages))
+        for i, p := range pages {
+            entries[i] = feeds.SitemapEntry{
+                Loc:     fmt.Sprintf("%s/sitemaps/posts-%d.xml", config.PublicBaseURL(), i+1),
+                LastMod: formatLastMod(latest(p)),
+            }
+        }
+        body, err := feeds.Marshal(feeds.NewSitemapIndex(entries))
+        if err != nil {
+            return nil, time.Time{}, err
+        }
+        lastModified, err := siteLastModified(s.blogRepo, updated)
+        return body, lastModified, err
+    }
+
+    // Numbered pages only exist while the index does
+    if page == 0 {
+        page = 1
+    } else if len(pages) == 1 || page > len(pages) {
+        return nil, time.Time{}, ErrSitemapNotFound
+    }
+
+    p := pages[page-1]
+    urls := make([]feeds.SitemapURL, len(p))
+    for i, item := range p {
+        urls[i] = item.url
+    }
+    body, err := feeds.Marshal(feeds.NewURLSet(urls))
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+    lastModified, err := siteLastModified(s.blogRepo, latest(p))
+    return body, lastModified, err
+}
+
+// sitemapItems lists the home page and every published post. Posts with published
+// translations get one URL per language, each linking to all the others with hreflang.
+func (s *SitemapService) sitemapItems() ([]sitemapItem, time.Time, error) {
+    posts, err := s.blogRepo.GetSitemapPosts()
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+    translations, err := s.translationRepo.GetPublishedForSitemap()
+    if err != nil {
+        return nil, time.Time{}, err
+    }
+
+    byPost := map[uint][]models.SitemapTranslation{}
+    for _, translation := range translations {
+        byPost[translation.BlogPostID] = append(byPost[translation.BlogPostID], translation)
+    }
+
+    // The home page changes with any post
+    items := []sitemapItem{{url: feeds.SitemapURL{Loc: config.FrontendURL() + "/"}}}
+    var updated time.Time
+
+    for _, post := range posts {
+        versions := []models.SitemapTranslation{{BlogPostID: post.ID, Language: post.Language, UpdatedAt: post.UpdatedAt}}
+        versions = append(versions, byPost[post.ID]...)
+
+        var alternates []feeds.SitemapAlternate
+        if len(versions) > 1 {
+            for _, version := range versions {
+                alternates = append(alternates, feeds.SitemapAlternate{
+                    Rel:      "alternate",
+                    Hreflang: version.Language,
+                    Href:     config.LocalizedPostURL(post.ID, version.Language, post.Language),
+                })
+            }
+            alternates = append(alternates, feeds.SitemapAlternate{
+                Rel:      "alternate",
+                Hreflang: "x-default",
+                Href:     config.PostURL(post.ID),
+            })
+        }
+
+        for _, version := range versions {
+            items = append(items, sitemapItem{
+                url: feeds.SitemapURL{
+                    Loc:        config.LocalizedPostURL(post.ID, version.Language, post.Language),
+                    LastMod:    formatLastMod(version.UpdatedAt),
+                    Alternates: alternates,
+                },
+                updated: version.UpdatedAt,
+            })
+            if version.UpdatedAt.After(updated) {
+                updated = version.UpdatedAt
+            }
+        }
+    }
+
+    items[0].updated = updated
+    items[0].url.LastMod = formatLastMod(updated)
+    return items, updated, nil
+}
+
+func latest(items []sitemapItem) time.Time {
+    var t time.Time
+    for _, item := range items {
+        if item.updated.After(t) {
+            t = item.updated
+        }
+    }
+    return t
+}
+
+func formatLastMod(t time.Time) string {
+    if t.IsZero() {
+        return ""
+    }
+    return t.UTC().Format(time.RFC3339)
+}
+
+// RobotsTxt points crawlers at the sitemap and keeps them out of private paths
+func (s *SitemapService) RobotsTxt() string {
+    var b strings.Builder
+    b.WriteString("User-agent: *\n")
+
+    if config.RobotsBlockAll() {
+        b.WriteString("Disallow: /\n")
+        return b.String()
+    }
+
+    disallow := config.RobotsDisallow()
+    if len(disallow) == 0 {
+        b.WriteString("Disallow:\n")
+    }
+    for _, path := range disallow {
+        b.WriteString("Disallow: " + path + "\n")
+    }
+    b.WriteString("\nSitemap: " + config.PublicBaseURL() + "/sitemap.xml\n")
+    return b.String()
+}
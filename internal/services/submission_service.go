@@ -0,0 +1,415 @@
+package services
+
+import (
+    "auth2_google/internal/captcha"
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+    "auth2_google/internal/storage"
+    "auth2_google/internal/utils"
+    "bytes"
+    "context"
+    "crypto/hmac"
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/base64"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "io"
+    "log"
+    "net/http"
+    "path/filepath"
+    "strings"
+    "time"
+)
+
+var (
+    ErrSubmissionNotFound = errors.New("submission not found")
+    ErrCaptchaFailed      = errors.New("captcha check failed, please try again")
+    ErrTooManySubmissions = errors.New("too many submissions today, please try again tomorrow")
+)
+
+// Most files a guest can attach to one pitch
+const submissionMaxFiles = 5
+
+// Attachment types we accept, detected from the file content
+var allowedAttachmentTypes = map[string]string{
+    "image/jpeg":                ".jpg",
+    "image/png":                 ".png",
+    "image/gif":                 ".gif",
+    "image/webp":                ".webp",
+    "application/pdf":           ".pdf",
+    "text/plain; charset=utf-8": ".txt",
+}
+
+// Office documents are zip files inside, so these are trusted by extension
+var zipDocumentTypes = map[string]string{
+    ".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+    ".odt":  "application/vnd.oasis.opendocument.text",
+}
+
+// SubmissionFile is an attachment as it came in with the form
+type SubmissionFile struct {
+    Filename string
+    Data     []byte
+}
+
+type SubmissionServiceInterface interface {
+    Submit(ctx context.Context, req models.SubmissionRequest, files []SubmissionFile, ip string) (*models.SubmissionReceipt, error)
+    GetStatus(token string) (*models.SubmissionStatusResponse, error)
+    GetSubmissions(status string) ([]models.SubmissionResponse, error)
+    GetSubmission(id uint) (*models.SubmissionResponse, error)
+    OpenAttachment(ctx context.Context, submissionID, attachmentID uint) (io.ReadSeekCloser, *models.SubmissionAttachment, error)
+    SetStatus(id, reviewerID uint, req models.SubmissionStatusRequest) (*models.SubmissionResponse, error)
+    Accept(id, reviewerID uint, req models.AcceptSubmissionRequest) (*models.SubmissionResponse, error)
+    MaxAttachmentBytes() int64
+    MaxAttachments() int
+}
+
+type SubmissionService struct {
+    submissionRepo repositories.SubmissionRepositoryInterface
+    blogRepo       repositories.BlogRepositoryInterface
+    userRepo       repositories.UserRepositoryInterface
+    store          storage.Storage
+    verifier       captcha.Verifier
+    searchIndex    search.SearchIndex
+    maxBytes       int64
+}
+
+func NewSubmissionService(submissionRepo repositories.SubmissionRepositoryInterface, blogRepo repositories.BlogRepositoryInterface, userRepo repositories.UserRepositoryInterface, store storage.Storage, verifier captcha.Verifier, searchIndex search.SearchIndex, maxBytes int64) SubmissionServiceInterface {
+    return &SubmissionService{
+        submissionRepo: submissionRepo,
+        blogRepo:       blogRepo,
+        userRepo:       userRepo,
+        store:          store,
+        verifier:       verifier,
+        searchIndex:    searchIndex,
+        maxBytes:       maxBytes,
+    }
+}
+
+func (s *SubmissionService) MaxAttachmentBytes() int64 {
+    return s.maxBytes
+}
+
+func (s *SubmissionService) MaxAttachments() int {
+    return submissionMaxFiles
+}
+
+// The status link token is only ever shown to the guest, we keep its hash
+func hashSubmissionToken(token string) string {
+    sum := sha256.Sum256([]byte(token))
+    return hex.EncodeToString(sum[:])
+}
+
+// submitterKey identifies a guest for the daily limit. The secret outlives
+// restarts and midnight, so the 24 hour window really is 24 hours.
+func submitterKey(ip string) string {
+    mac := hmac.New(sha256.New, []byte(config.SubmissionRateSecret()))
+    mac.Write([]byte(ip))
+    return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+func newSubmissionToken() (string, error) {
+    buf := make([]byte, 24)
+    if _, err := rand.Read(buf); err != nil {
+        return "", err
+    }
+    return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func attachmentType(filename string, data []byte) (contentType, ext string, ok bool) {
+    contentType = http.DetectContentType(data)
+    if ext, ok = allowedAttachmentTypes[contentType]; ok {
+        return contentType, ext, true
+    }
+    if contentType == "application/zip" {
+        ext = strings.ToLower(filepath.Ext(filename))
+        if documentType, ok := zipDocumentTypes[ext]; ok {
+            return documentType, ext, true
+        }
+    }
+    return "", "", false
+}
+
+func toSubmissionResponse(submission models.Submission) models.SubmissionResponse {
+    response := models.SubmissionResponse{
+        ID:          submission.ID,
+        Title:       submission.Title,
+        Excerpt:     submission.Excerpt,
+        Content:     submission.Content,
+        Name:        submission.Name,
+        Email:       submission.Email,
+        Bio:         submission.Bio,
+        Language:    submission.Language,
+        Status:      submission.Status,
+        Message:     submission.Message,
+        BlogPostID:  submission.BlogPostID,
+        Attachments: []models.SubmissionAttachmentResponse{},
+        CreatedAt:   isoTime(submission.CreatedAt),
+        UpdatedAt:   isoTime(submission.UpdatedAt),
+    }
+    for _, attachment := range submission.Attachments {
+        response.Attachments = append(response.Attachments, models.SubmissionAttachmentResponse{
+            ID:           attachment.ID,
+            OriginalName: attachment.OriginalName,
+            ContentType:  attachment.ContentType,
+            Size:         attachment.Size,
+            URL:          fmt.Sprintf("%s/api/submissions/%d/attachments/%d", config.PublicBaseURL(), submission.ID, attachment.ID),
+        })
+    }
+    return response
+}
+
+func submissionReceipt(token string) *models.SubmissionReceipt {
+    return &models.SubmissionReceipt{
+        Status:    models.SubmissionPending,
+        Token:     token,
+        StatusURL: config.SubmissionStatusURL(token),
+    }
+}
+
+// Submit stores a guest's pitch and returns the secret link to follow it
+func (s *SubmissionService) Submit(ctx context.Context, req models.SubmissionRequest, files []SubmissionFile, ip string) (*models.SubmissionReceipt, error) {
+    token, err := newSubmissionToken()
+    if err != nil {
+        return nil, err
+    }
+
+    // Bots get a normal-looking answer so they don't learn to skip the field
+    if req.Website != "" {
+        log.Printf("⚠️ Dropped submission %q, honeypot was filled in", req.Title)
+        return submissionReceipt(token), nil
+    }
+
+    if err := s.verifier.Verify(ctx, req.CaptchaToken, ip); err != nil {
+        if errors.Is(err, captcha.ErrFailed) {
+            return nil, ErrCaptchaFailed
+        }
+        return nil, err
+    }
+
+    language := req.Language
+    if language == "" {
+        language = config.DefaultLanguage()
+    }
+    if !config.IsSupportedLanguage(language) {
+        return nil, ErrUnsupportedLanguage
+    }
+
+    now := time.Now()
+    visitor := submitterKey(ip)
+    count, err := s.submissionRepo.CountByVisitorSince(visitor, now.Add(-24*time.Hour))
+    if err != nil {
+        return nil, err
+    }
+    if count >= int64(config.SubmissionDailyLimit()) {
+        return nil, ErrTooManySubmissions
+    }
+
+    // Check every file before storing any of them
+    if len(files) > submissionMaxFiles {
+        return nil, fmt.Errorf("at most %d attachments are allowed", submissionMaxFiles)
+    }
+    attachments := make([]models.SubmissionAttachment, len(files))
+    extensions := make([]string, len(files))
+    for i, file := range files {
+        if int64(len(file.Data)) > s.maxBytes {
+            return nil, ErrFileTooLarge
+        }
+        contentType, ext, ok := attachmentType(file.Filename, file.Data)
+        if !ok {
+            return nil, ErrUnsupportedMediaType
+        }
+        attachments[i] = models.SubmissionAttachment{
+            OriginalName: filepath.Base(file.Filename),
+            ContentType:  contentType,
+            Size:         int64(len(file.Data)),
+        }
+        extensions[i] = ext
+    }
+
+    // The random folder keeps attachments unguessable on public storage
+    folder := make([]byte, 16)
+    if _, err := rand.Read(folder); err != nil {
+        return nil, err
+    }
+    for i, file := range files {
+        sum := sha256.Sum256(file.Data)
+        key := fmt.Sprintf("submissions/%s/%s%s", hex.EncodeToString(folder), hex.EncodeToString(sum[:]), extensions[i])
+        if err := s.store.Put(ctx, key, bytes.NewReader(file.Data), int64(len(file.Data)), attachments[i].ContentType); err != nil {
+            s.removeAttachments(attachments[:i])
+            return nil, fmt.Errorf("failed to store attachment: %v", err)
+        }
+        attachments[i].StorageKey = key
+    }
+
+    submission := &models.Submission{
+        Title:       strings.TrimSpace(req.Title),
+        Excerpt:     strings.TrimSpace(req.Excerpt),
+        Content:     req.Content,
+        Name:        strings.TrimSpace(req.Name),
+        Email:       strings.TrimSpace(req.Email),
+        Bio:         strings.TrimSpace(req.Bio),
+        Language:    language,
+        Status:      models.SubmissionPending,
+        TokenHash:   hashSubmissionToken(token),
+        VisitorHash: visitor,
+        Attachments: attachments,
+    }
+    if err := s.submissionRepo.Create(submission); err != nil {
+        s.removeAttachments(attachments)
+        return nil, err
+    }
+
+    log.Printf("✅ New submission %d: %q by %s", submission.ID, submission.Title, submission.Name)
+    return submissionReceipt(token), nil
+}
+
+func (s *SubmissionService) removeAttachments(attachments []models.SubmissionAttachment) {
+    for _, attachment := range attachments {
+        if err := s.store.Delete(context.Background(), attachment.StorageKey); err != nil {
+            log.Printf("⚠️ Failed to remove attachment %s: %v", attachment.StorageKey, err)
+        }
+    }
+}
+
+// GetStatus is what the guest sees through their secret link
+func (s *SubmissionService) GetStatus(token string) (*models.SubmissionStatusResponse, error) {
+    submission, err := s.submissionRepo.GetByTokenHash(hashSubmissionToken(token))
+    if err != nil {
+        return nil, ErrSubmissionNotFound
+    }
+
+    response := &models.SubmissionStatusResponse{
+        Title:       submission.Title,
+        Status:      submission.Status,
+        Message:     submission.Message,
+        SubmittedAt: isoTime(submission.CreatedAt),
+        UpdatedAt:   isoTime(submission.UpdatedAt),
+    }
+    if submission.BlogPostID != nil {
+        if published, err := s.blogRepo.IsPublished(*submission.BlogPostID); err == nil && published {
+            response.PostURL = config.PostURL(*submission.BlogPostID)
+        }
+    }
+    return response, nil
+}
+
+func (s *SubmissionService) GetSubmissions(status string) ([]models.SubmissionResponse, error) {
+    submissions, err := s.submissionRepo.GetAll(status)
+    if err != nil {
+        return nil, err
+    }
+
+    responses := []models.SubmissionResponse{}
+    for _, submission := range submissions {
+        responses = append(responses, toSubmissionResponse(submission))
+    }
+    return responses, nil
+}
+
+func (s *SubmissionService) GetSubmission(id uint) (*models.SubmissionResponse, error) {
+    submission, err := s.submissionRepo.GetByID(id)
+    if err != nil {
+        return nil, ErrSubmissionNotFound
+    }
+    response := toSubmissionResponse(*submission)
+    return &response, nil
+}
+
+func (s *SubmissionService) OpenAttachment(ctx context.Context, submissionID, attachmentID uint) (io.ReadSeekCloser, *models.SubmissionAttachment, error) {
+    submission, err := s.submissionRepo.GetByID(submissionID)
+    if err != nil {
+        return nil, nil, ErrSubmissionNotFound
+    }
+
+    for _, attachment := range submission.Attachments {
+        if attachment.ID == attachmentID {
+            file, err := s.store.Open(ctx, attachment.StorageKey)
+            if err != nil {
+                return nil, nil, fmt.Errorf("failed to open attachment: %v", err)
+            }
+            return file, &attachment, nil
+        }
+    }
+    return nil, nil, errors.New("attachment not found")
+}
+
+// SetStatus moves a pitch along without accepting it
+func (s *SubmissionService) SetStatus(id, reviewerID uint, req models.SubmissionStatusRequest) (*models.SubmissionResponse, error) {
+    switch req.Status {
+    case models.SubmissionPending, models.SubmissionReviewing, models.SubmissionRejected:
+    case models.SubmissionAccepted:
+        return nil, errors.New("use the accept endpoint to accept a submission")
+    default:
+        return nil, errors.New("status must be pending, reviewing or rejected")
+    }
+
+    submission, err := s.submissionRepo.GetByID(id)
+    if err != nil {
+        return nil, ErrSubmissionNotFound
+    }
+    if submission.Status == models.SubmissionAccepted {
+        return nil, errors.New("submission was already accepted")
+    }
+
+    submission.Status = req.Status
+    submission.Message = req.Message
+    submission.ReviewedBy = &reviewerID
+    if err := s.submissionRepo.Update(submission); err != nil {
+        return nil, err
+    }
+
+    response := toSubmissionResponse(*submission)
+    return &response, nil
+}
+
+// Accept turns the pitch into a draft post for editors to polish and publish
+func (s *SubmissionService) Accept(id, reviewerID uint, req models.AcceptSubmissionRequest) (*models.SubmissionResponse, error) {
+    submission, err := s.submissionRepo.GetByID(id)
+    if err != nil {
+        return nil, ErrSubmissionNotFound
+    }
+    if submission.Status == models.SubmissionAccepted {
+        return nil, errors.New("submission was already accepted")
+    }
+
+    excerpt := submission.Excerpt
+    if excerpt == "" {
+        excerpt = utils.Truncate(utils.StripHTML(submission.Content), 200)
+    }
+
+    post := &models.BlogPost{
+        Title:    submission.Title,
+        Excerpt:  excerpt,
+        Author:   submission.Name, // Guests have no account to link
+        Content:  utils.TextToHTML(submission.Content),
+        Language: submission.Language,
+    }
+    if req.AuthorID != nil {
+        author, err := s.userRepo.GetByID(*req.AuthorID)
+        if err != nil {
+            return nil, errors.New("author not found")
+        }
+        post.AuthorID = &author.ID
+        post.Author = author.Name
+        post.Authors = []models.PostAuthor{{UserID: author.ID}}
+    }
+    post.WordCount, post.ReadingMinutes = utils.ReadingStats(post.Content)
+
+    submission.Message = req.Message
+    submission.ReviewedBy = &reviewerID
+    if err := s.submissionRepo.Accept(submission, post); err != nil {
+        return nil, err
+    }
+    if err := s.searchIndex.Index(*post); err != nil {
+        log.Printf("⚠️ Failed to index post %d: %v", post.ID, err)
+    }
+
+    log.Printf("✅ Submission %d accepted as draft post %d", submission.ID, post.ID)
+    response := toSubmissionResponse(*submission)
+    return &response, nil
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/utils"
+    "errors"
+    "time"
+)
+
+var (
+    ErrUnsupportedLanguage = errors.New("unsupported language")
+    ErrTranslationNotFound = errors.New("translation not found")
+)
+
+type TranslationServiceInterface interface {
+    GetLanguages(postID uint, userID *uint) ([]models.PostLanguageResponse, error)
+    GetTranslations(postID, userID uint) ([]models.TranslationResponse, error)
+    SaveTranslation(postID, userID uint, lang string, req models.SaveTranslationRequest) (*models.TranslationResponse, error)
+    DeleteTranslation(postID, userID uint, lang string) error
+}
+
+type TranslationService struct {
+    blogRepo        repositories.BlogRepositoryInterface
+    translationRepo repositories.TranslationRepositoryInterface
+    userRepo        repositories.UserRepositoryInterface
+}
+
+func NewTranslationService(blogRepo repositories.BlogRepositoryInterface, translationRepo repositories.TranslationRepositoryInterface, userRepo repositories.UserRepositoryInterface) TranslationServiceInterface {
+    return &TranslationService{
+        blogRepo:        blogRepo,
+        translationRepo: translationRepo,
+        userRepo:        userRepo,
+    }
+}
+
+func toTranslationResponse(translation models.PostTranslation) *models.TranslationResponse {
+    return &models.TranslationResponse{
+        Language:  translation.Language,
+        Title:     translation.Title,
+        Excerpt:   translation.Excerpt,
+        Content:   translation.Content,
+        Published: translation.Published,
+        UpdatedAt: isoTime(translation.UpdatedAt),
+
+        WordCount:      translation.WordCount,
+        ReadingMinutes: translation.ReadingMinutes,
+    }
+}
+
+// GetLanguages lists the languages readers can get the post in, canonical first.
+// Unpublished translations are left out, and drafts look missing like in GetPostByID.
+func (s *TranslationService) GetLanguages(postID uint, userID *uint) ([]models.PostLanguageResponse, error) {
+    post, err := s.blogRepo.GetByID(postID)
+    if err != nil || !canRead(post, loadReader(s.userRepo, userID)) {
+        return nil, ErrPostNotFound
+    }
+
+    languages := []models.PostLanguageResponse{{
+        Language:  post.Language,
+        Title:     post.Title,
+        URL:       config.LocalizedPostURL(post.ID, post.Language, post.Language),
+        Canonical: true,
+        Published: post.Published,
+    }}
+    for _, translation := range post.Translations {
+        if !translation.Published {
+            continue
+        }
+        languages = append(languages, models.PostLanguageResponse{
+            Language:  translation.Language,
+            Title:     translation.Title,
+            URL:       config.LocalizedPostURL(post.ID, translation.Language, post.Language),
+            Published: true,
+        })
+    }
+    return languages, nil
+}
+
+// GetTranslations returns every translation including drafts, for editing
+func (s *TranslationService) GetTranslations(postID, userID uint) ([]models.TranslationResponse, error) {
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID); err != nil {
+        return nil, err
+    }
+
+    translations, err := s.translationRepo.GetByBlogPostID(postID)
+    if err != nil {
+        return nil, err
+    }
+
+    responses := []models.TranslationResponse{}
+    for _, translation := range translations {
+        responses = append(responses, *toTranslationResponse(translation))
+    }
+    return responses, nil
+}
+
+// SaveTranslation creates or replaces the post's text in lang
+func (s *TranslationService) SaveTranslation(postID, userID uint, lang string, req models.SaveTranslationRequest) (*models.TranslationResponse, error) {
+    post, user, err := editablePost(s.blogRepo, s.userRepo, postID, userID)
+    if err != nil {
+        return nil, err
+    }
+    if !config.IsSupportedLanguage(lang) {
+        return nil, ErrUnsupportedLanguage
+    }
+    if lang == post.Language {
+        return nil, errors.New("that is the post's own language, update the post instead")
+    }
+    if req.Published && !mayPublish(post, user) {
+        return nil, ErrNeedsApproval
+    }
+
+    translation, err := s.translationRepo.Get(postID, lang)
+    if err != nil {
+        translation = &models.PostTranslation{BlogPostID: postID, Language: lang}
+    }
+    translation.Title = req.Title
+    translation.Excerpt = req.Excerpt
+    translation.Content = req.Content
+    translation.WordCount, translation.ReadingMinutes = utils.ReadingStats(req.Content)
+    translation.Published = req.Published
+    if req.Published && translation.PublishedAt == nil {
+        now := time.Now()
+        translation.PublishedAt = &now
+    }
+
+    if err := s.translationRepo.Save(translation); err != nil {
+        return nil, err
+    }
+    return toTranslationResponse(*translation), nil
+}
+
+func (s *TranslationService) DeleteTranslation(postID, userID uint, lang string) error {
+    if _, _, err := editablePost(s.blogRepo, s.userRepo, postID, userID); err != nil {
+        return err
+    }
+    translation, err := s.translationRepo.Get(postID, lang)
+    if err != nil {
+        return ErrTranslationNotFound
+    }
+    if err := s.translationRepo.Delete(translation.ID); err != nil {
+        return err
+    }
+    // Feeds and the sitemap only see the post's updated_at move
+    return s.blogRepo.Touch(postID)
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+    "auth2_google/internal/config"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
+    "auth2_google/internal/utils"
+    "context"
+    "errors"
+    "log"
+    "time"
+)
+
+var ErrNotInTrash = errors.New("not found in trash")
+
+// How much of a comment stands in for its title in the trash list
+const trashCommentTitleLength = 80
+
+type TrashServiceInterface interface {
+    GetTrash() (*models.TrashResponse, error)
+    RestorePost(id uint) error
+    RestoreComment(id uint) error
+    PurgePost(ctx context.Context, id uint) error
+    PurgeComment(id uint) error
+    PurgeExpired(ctx context.Context, now time.Time) error
+    Run(interval time.Duration)
+}
+
+type TrashService struct {
+    trashRepo    repositories.TrashRepositoryInterface
+    blogRepo     repositories.BlogRepositoryInterface
+    audioService AudioServiceInterface
+    searchIndex  search.SearchIndex
+    related      *search.RelatedIndex
+}
+
+func NewTrashService(trashRepo repositories.TrashRepositoryInterface, blogRepo repositories.BlogRepositoryInterface, audioService AudioServiceInterface, searchIndex search.SearchIndex, related *search.RelatedIndex) TrashServiceInterface {
+    return &TrashService{
+        trashRepo:    trashRepo,
+        blogRepo:     blogRepo,
+        audioService: audioService,
+        searchIndex:  searchIndex,
+        related:      related,
+    }
+}
+
+// When the retention job will purge something deleted at deletedAt, "" if it never will
+func purgeAt(deletedAt time.Time) string {
+    retention := config.TrashRetention()
+    if retention == 0 {
+        return ""
+    }
+    return isoTime(deletedAt.Add(retention))
+}
+
+func (s *TrashService) GetTrash() (*models.TrashResponse, error) {
+    posts, err := s.trashRepo.GetDeletedPosts()
+    if err != nil {
+        return nil, err
+    }
+    comments, err := s.trashRepo.GetDeletedComments()
+    if err != nil {
+        return nil, err
+    }
+
+    response := &models.TrashResponse{
+        Posts:         []models.TrashItemResponse{},
+        Comments:      []models.TrashItemResponse{},
+        RetentionDays: int(config.TrashRetention() / (24 * time.Hour)),
+    }
+    for _, post := range posts {
+        response.Posts = append(response.Posts, models.TrashItemResponse{
+            ID:        post.ID,
+            Title:     post.Title,
+            Author:    post.Author,
+            DeletedAt: isoTime(post.DeletedAt.Time),
+            PurgeAt:   purgeAt(post.DeletedAt.Time),
+        })
+    }
+    for _, comment := range comments {
+        postID := comment.BlogPostID
+        response.Comments = append(response.Comments, models.TrashItemResponse{
+            ID:         comment.ID,
+            Title:      utils.Truncate(comment.Text, trashCommentTitleLength),
+            Author:     comment.Name,
+            BlogPostID: &postID,
+            DeletedAt:  isoTime(comment.DeletedAt.Time),
+            PurgeAt:    purgeAt(comment.DeletedAt.Time),
+        })
+    }
+    return response, nil
+}
+
+// RestorePost brings the post back and puts it back in search and related posts
+func (s *TrashService) RestorePost(id uint) error {
+    if _, err := s.trashRepo.GetDeletedPost(id); err != nil {
+        return ErrNotInTrash
+    }
+    if err := s.trashRepo.RestorePost(id); err != nil {
+        return err
+    }
+
+    post, err := s.blogRepo.GetByID(id)
+    if err != nil {
+        return err
+    }
+    if err := s.searchIndex.Index(*post); err != nil {
+        log.Printf("⚠️ Failed to index post %d: %v", id, err)
+    }
+    s.related.Invalidate()
+    return nil
+}
+
+// RestoreComment needs its post to be live, a comment on a deleted post would stay hidden
+func (s *TrashService) RestoreComment(id uint) error {
+    comment, err := s.trashRepo.GetDeletedComment(id)
+    if err != nil {
+        return ErrNotInTrash
+    }
+    if _, err := s.blogRepo.GetByID(comment.BlogPostID); err != nil {
+        return errors.New("the comment's post is deleted, restore the post first")
+    }
+    return s.trashRepo.RestoreComment(id)
+}
+
+// PurgePost deletes a trashed post for good. Only posts already in the trash can be purged.
+func (s *TrashService) PurgePost(ctx context.Context, id uint) error {
+    if _, err := s.trashRepo.GetDeletedPost(id); err != nil {
+        return ErrNotInTrash
+    }
+
+    // Narration files can be shared, the audio service only deletes the file once
+    // nothing uses it. Most posts have no narration, so a failure here is expected.
+    _ = s.audioService.RemoveAudio(ctx, id)
+
+    return s.trashRepo.PurgePost(id)
+}
+
+func (s *TrashService) PurgeComment(id uint) error {
+    if _, err := s.trashRepo.GetDeletedComment(id); err != nil {
+        return ErrNotInTrash
+    }
+    return s.trashRepo.PurgeComment(id)
+}
+
+// PurgeExpired purges everything that has been in the trash longer than the retention period
+func (s *TrashService) PurgeExpired(ctx context.Context, now time.Time) error {
+    retention := config.TrashRetention()
+    if retention == 0 {
+        return nil
+    }
+    before := now.Add(-retention)
+
+    postIDs, err := s.trashRepo.GetExpiredPostIDs(before)
+    if err != nil {
+        return err
+    }
+    // One item that can't be purged shouldn't hold up the rest
+    posts, comments := 0, 0
+    for _, id := range postIDs {
+        _ = s.audioService.RemoveAudio(ctx, id)
+        if err := s.trashRepo.PurgePost(id); err != nil {
+            log.Printf("⚠️ Failed to purge post %d: %v", id, err)
+            continue
+        }
+        posts++
+    }
+
+    commentIDs, err := s.trashRepo.GetExpiredCommentIDs(before)
+    if err != nil {
+        return err
+    }
+    for _, id := range commentIDs {
+        if err := s.trashRepo.PurgeComment(id); err != nil {
+            log.Printf("⚠️ Failed to purge comment %d: %v", id, err)
+            continue
+        }
+        comments++
+    }
+
+    if posts > 0 || comments > 0 {
+        log.Printf("✅ Purged %d posts and %d comments from the trash", posts, comments)
+    }
+    return nil
+}
+
+// Run purges expired trash now and then every interval. Meant to run in its own goroutine.
+func (s *TrashService) Run(interval time.Duration) {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+
+    for {
+        if err := s.PurgeExpired(context.Background(), time.Now()); err != nil {
+            log.Printf("⚠️ Failed to purge expired trash: %v", err)
+        }
+        <-ticker.C
+    }
+}
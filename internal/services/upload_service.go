@@ -0,0 +1,193 @@
+package services
+
+import (
+    "auth2_google/internal/imaging"
+    "auth2_google/internal/models"
+    "auth2_google/internal/repositories"
+    "auth2_google/internal/storage"
+    "bytes"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "errors"
+    "fmt"
+    "net/http"
+    "strings"
+)
+
+var (
+    ErrUnsupportedMediaType = errors.New("unsupported file type")
+    ErrFileTooLarge         = errors.New("file is too large")
+    ErrImageTooLarge        = errors.New("image dimensions are too large")
+)
+
+// Image types we accept, detected from the file content rather than the client's Content-Type
+var allowedImageTypes = map[string]string{
+    "image/jpeg": ".jpg",
+    "image/png":  ".png",
+    "image/gif":  ".gif",
+    "image/webp": ".webp",
+}
+
+var variantExtensions = map[string]string{
+    "jpeg": "jpg",
+    "webp": "webp",
+}
+
+type UploadServiceInterface interface {
+    UploadImage(ctx context.Context, userID uint, filename string, data []byte) (*models.MediaResponse, error)
+    GetUserMedia(userID uint) ([]models.MediaResponse, error)
+    MaxImageBytes() int64
+}
+
+type UploadService struct {
+    mediaRepo     repositories.MediaRepositoryInterface
+    store         storage.Storage
+    processor     *imaging.Processor
+    maxImageBytes int64
+}
+
+func NewUploadService(mediaRepo repositories.MediaRepositoryInterface, store storage.Storage, processor *imaging.Processor, maxImageBytes int64) UploadServiceInterface {
+    return &UploadService{
+        mediaRepo:     mediaRepo,
+        store:         store,
+        processor:     processor,
+        maxImageBytes: maxImageBytes,
+    }
+}
+
+func (s *UploadService) toMediaResponse(media models.Media) models.MediaResponse {
+    return models.MediaResponse{
+        ID:            media.ID,
+        URL:           media.URL,
+        ContentType:   media.ContentType,
+        Size:          media.Size,
+        OriginalName:  media.OriginalName,
+        CreatedAt:     isoTime(media.CreatedAt),
+        ImageResponse: toImageResponse(media),
+    }
+}
+
+// Build the srcset-ready description of an image, nil when it has no variants
+func toImageResponse(media models.Media) *models.ImageResponse {
+    if len(media.Variants) == 0 {
+        return nil
+    }
+
+    response := &models.ImageResponse{
+        Width:    media.Width,
+        Height:   media.Height,
+        Blurhash: media.Blurhash,
+        Srcset:   map[string]string{},
+        Variants: []models.ImageVariantResponse{},
+    }
+
+    candidates := map[string][]string{}
+    for _, variant := range media.Variants {
+        response.Variants = append(response.Variants, models.ImageVariantResponse{
+            Name:   variant.Name,
+            Format: variant.Format,
+            URL:    variant.URL,
+            Width:  variant.Width,
+            Height: variant.Height,
+        })
+        candidates[variant.Format] = append(candidates[variant.Format], fmt.Sprintf("%s %dw", variant.URL, variant.Width))
+    }
+    for format, list := range candidates {
+        response.Srcset[format] = strings.Join(list, ", ")
+    }
+
+    return response
+}
+
+func (s *UploadService) MaxImageBytes() int64 {
+    return s.maxImageBytes
+}
+
+func (s *UploadService) UploadImage(ctx context.Context, userID uint, filename string, data []byte) (*models.MediaResponse, error) {
+    if int64(len(data)) > s.maxImageBytes {
+        return nil, ErrFileTooLarge
+    }
+
+    contentType := http.DetectContentType(data)
+    ext, ok := allowedImageTypes[contentType]
+    if !ok {
+        return nil, ErrUnsupportedMediaType
+    }
+
+    sum := sha256.Sum256(data)
+    hash := hex.EncodeToString(sum[:])
+
+    // Same file uploaded again by the same user, reuse it
+    if existing, err := s.mediaRepo.GetByUserAndHash(userID, hash); err == nil {
+        response := s.toMediaResponse(*existing)
+        return &response, nil
+    }
+
+    // Strips EXIF/GPS metadata and builds the resized variants
+    processed, err := s.processor.Process(data, contentType)
+    if errors.Is(err, imaging.ErrUnsupportedImage) {
+        return nil, ErrUnsupportedMediaType
+    }
+    if errors.Is(err, imaging.ErrImageTooLarge) {
+        return nil, ErrImageTooLarge
+    }
+    if err != nil {
+        return nil, fmt.Errorf("failed to process image: %v", err)
+    }
+
+    prefix := fmt.Sprintf("images/%s/%s", hash[:2], hash)
+    key := prefix + ext
+    if err := s.store.Put(ctx, key, bytes.NewReader(processed.Original), int64(len(processed.Original)), contentType); err != nil {
+        return nil, fmt.Errorf("failed to store file: %v", err)
+    }
+
+    media := &models.Media{
+        UserID:       userID,
+        Hash:         hash,
+        Key:          key,
+        URL:          s.store.URL(key),
+        ContentType:  contentType,
+        Size:         int64(len(processed.Original)),
+        OriginalName: filename,
+        Width:        processed.Width,
+        Height:       processed.Height,
+        Blurhash:     processed.Blurhash,
+    }
+
+    for _, variant := range processed.Variants {
+        variantKey := fmt.Sprintf("%s-%s.%s", prefix, variant.Name, variantExtensions[variant.Format])
+        if err := s.store.Put(ctx, variantKey, bytes.NewReader(variant.Data), int64(len(variant.Data)), variant.ContentType); err != nil {
+            return nil, fmt.Errorf("failed to store image variant: %v", err)
+        }
+        media.Variants = append(media.Variants, models.MediaVariant{
+            Name:   variant.Name,
+            Format: variant.Format,
+            Key:    variantKey,
+            URL:    s.store.URL(variantKey),
+            Width:  variant.Width,
+            Height: variant.Height,
+            Size:   int64(len(variant.Data)),
+        })
+    }
+    if err := s.mediaRepo.Create(media); err != nil {
+        return nil, err
+    }
+
+    response := s.toMediaResponse(*media)
+    return &response, nil
+}
+
+func (s *UploadService) GetUserMedia(userID uint) ([]models.MediaResponse, error) {
+    media, err := s.mediaRepo.GetByUserID(userID)
+    if err != nil {
+        return nil, err
+    }
+
+    responses := []models.MediaResponse{}
+    for _, item := range media {
+        responses = append(responses, s.toMediaResponse(item))
+    }
+
+    return responses, nil
+}
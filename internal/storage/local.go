@@ -0,0 +1,87 @@
+package storage
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "os"
+    "path/filepath"
+    "strings"
+)
+
+// LocalStorage keeps files on disk. The router serves the directory under /uploads.
+type LocalStorage struct {
+    dir     string
+    baseURL string
+}
+
+func NewLocalStorage(dir, baseURL string) *LocalStorage {
+    return &LocalStorage{
+        dir:     dir,
+        baseURL: strings.TrimRight(baseURL, "/"),
+    }
+}
+
+// Dir is the root directory, used to mount it as static files
+func (l *LocalStorage) Dir() string {
+    return l.dir
+}
+
+func (l *LocalStorage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+    path, err := l.path(key)
+    if err != nil {
+        return err
+    }
+    if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+        return fmt.Errorf("failed to create upload directory: %v", err)
+    }
+
+    // Write to a temp file first so readers never see a partial file
+    tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+    if err != nil {
+        return fmt.Errorf("failed to create file: %v", err)
+    }
+    defer os.Remove(tmp.Name())
+
+    if _, err := io.Copy(tmp, body); err != nil {
+        tmp.Close()
+        return fmt.Errorf("failed to write file: %v", err)
+    }
+    if err := tmp.Close(); err != nil {
+        return fmt.Errorf("failed to write file: %v", err)
+    }
+    return os.Rename(tmp.Name(), path)
+}
+
+func (l *LocalStorage) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+    path, err := l.path(key)
+    if err != nil {
+        return nil, err
+    }
+    return os.Open(path)
+}
+
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+    path, err := l.path(key)
+    if err != nil {
+        return err
+    }
+    if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+        return fmt.Errorf("failed to delete file: %v", err)
+    }
+    return nil
+}
+
+func (l *LocalStorage) URL(key string) string {
+    return l.baseURL + "/" + key
+}
+
+// Resolve a key inside the storage directory, rejecting anything that escapes it
+func (l *LocalStorage) path(key string) (string, error) {
+    clean := filepath.Clean("/" + key)
+    if clean == "/" {
+        return "", fmt.Errorf("invalid storage key %q", key)
+    }
+    return filepath.Join(l.dir, clean), nil
+}
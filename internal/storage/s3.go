@@ -0,0 +1,237 @@
+package storage
+
+import (
+    "context"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "io"
+    "net/http"
+    "strings"
+    "time"
+)
+
+// S3Config works for AWS S3 and compatible services (Cloudflare R2, MinIO, ...)
+type S3Config struct {
+    Endpoint        string // e.g. https://s3.eu-north-1.amazonaws.com
+    Region          string
+    Bucket          string
+    AccessKeyID     string
+    SecretAccessKey string
+    PublicURL       string // Optional CDN/public bucket URL, defaults to the path-style object URL
+}
+
+// S3Storage talks to the S3 REST API directly with path-style requests signed with SigV4
+type S3Storage struct {
+    cfg    S3Config
+    client *http.Client
+}
+
+func NewS3Storage(cfg S3Config) *S3Storage {
+    cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+    cfg.PublicURL = strings.TrimRight(cfg.PublicURL, "/")
+    if cfg.Region == "" {
+        cfg.Region = "auto"
+    }
+    return &S3Storage{
+        cfg:    cfg,
+        client: &http.Client{Timeout: 5 * time.Minute},
+    }
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+    req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), body)
+    if err != nil {
+        return err
+    }
+    req.ContentLength = size
+    req.Header.Set("Content-Type", contentType)
+    req.Header.Set("Cache-Control", "public, max-age=31536000, immutable") // Keys are content-hashed
+
+    return s.do(req)
+}
+
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+    req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key), nil)
+    if err != nil {
+        return nil, err
+    }
+    s.sign(req, time.Now().UTC())
+
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return nil, fmt.Errorf("storage request failed: %v", err)
+    }
+    resp.Body.Close()
+    if resp.StatusCode >= 300 {
+        return nil, fmt.Errorf("storage returned %s", resp.Status)
+    }
+
+    return &s3Object{storage: s, ctx: ctx, key: key, size: resp.ContentLength}, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+    req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key), nil)
+    if err != nil {
+        return err
+    }
+    return s.do(req)
+}
+
+func (s *S3Storage) URL(key string) string {
+    if s.cfg.PublicURL != "" {
+        return s.cfg.PublicURL + "/" + escapePath(key)
+    }
+    return s.objectURL(key)
+}
+
+func (s *S3Storage) objectURL(key string) string {
+    return s.cfg.Endpoint + "/" + escapePath(s.cfg.Bucket) + "/" + escapePath(key)
+}
+
+func (s *S3Storage) do(req *http.Request) error {
+    s.sign(req, time.Now().UTC())
+
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return fmt.Errorf("storage request failed: %v", err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode >= 300 {
+        body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+        return fmt.Errorf("storage returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+    }
+    return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header.
+// The payload is sent unsigned so large files can be streamed without hashing them twice.
+func (s *S3Storage) sign(req *http.Request, now time.Time) {
+    const payloadHash = "UNSIGNED-PAYLOAD"
+    amzDate := now.Format("20060102T150405Z")
+    date := now.Format("20060102")
+
+    req.Header.Set("x-amz-date", amzDate)
+    req.Header.Set("x-amz-content-sha256", payloadHash)
+
+    signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+    canonicalRequest := strings.Join([]string{
+        req.Method,
+        req.URL.EscapedPath(),
+        req.URL.RawQuery,
+        "host:" + req.URL.Host,
+        "x-amz-content-sha256:" + payloadHash,
+        "x-amz-date:" + amzDate,
+        "",
+        signedHeaders,
+        payloadHash,
+    }, "\n")
+
+    scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+    canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+    stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+    key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+    key = hmacSHA256(key, s.cfg.Region)
+    key = hmacSHA256(key, "s3")
+    key = hmacSHA256(key, "aws4_request")
+    signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+    req.Header.Set("Authorization", fmt.Sprintf(
+        "AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+        s.cfg.AccessKeyID, scope, signedHeaders, signature,
+    ))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+    mac := hmac.New(sha256.New, key)
+    mac.Write([]byte(data))
+    return mac.Sum(nil)
+}
+
+// URI-encode everything except unreserved characters and slashes, as SigV4 expects
+func escapePath(key string) string {
+    var b strings.Builder
+    for _, c := range []byte(key) {
+        if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+            c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+            b.WriteByte(c)
+        } else {
+            fmt.Fprintf(&b, "%%%02X", c)
+        }
+    }
+    return b.String()
+}
+
+// s3Object reads an object lazily with ranged GETs. A seek drops the current
+// response and the next read starts a new one at the new offset.
+type s3Object struct {
+    storage *S3Storage
+    ctx     context.Context
+    key     string
+    size    int64
+    offset  int64
+    body    io.ReadCloser
+}
+
+func (o *s3Object) Read(p []byte) (int, error) {
+    if o.offset >= o.size {
+        return 0, io.EOF
+    }
+
+    if o.body == nil {
+        req, err := http.NewRequestWithContext(o.ctx, http.MethodGet, o.storage.objectURL(o.key), nil)
+        if err != nil {
+            return 0, err
+        }
+        req.Header.Set("Range", fmt.Sprintf("bytes=%d-", o.offset))
+        o.storage.sign(req, time.Now().UTC())
+
+        resp, err := o.storage.client.Do(req)
+        if err != nil {
+            return 0, fmt.Errorf("storage request failed: %v", err)
+        }
+        if resp.StatusCode != http.StatusPartialContent && resp.StatusCode != http.StatusOK {
+            resp.Body.Close()
+            return 0, fmt.Errorf("storage returned %s", resp.Status)
+        }
+        o.body = resp.Body
+    }
+
+    n, err := o.body.Read(p)
+    o.offset += int64(n)
+    return n, err
+}
+
+func (o *s3Object) Seek(offset int64, whence int) (int64, error) {
+    var target int64
+    switch whence {
+    case io.SeekStart:
+        target = offset
+    case io.SeekCurrent:
+        target = o.offset + offset
+    case io.SeekEnd:
+        target = o.size + offset
+    default:
+        return 0, fmt.Errorf("invalid whence %d", whence)
+    }
+    if target < 0 {
+        return 0, fmt.Errorf("negative position %d", target)
+    }
+
+    if target != o.offset && o.body != nil {
+        o.body.Close()
+        o.body = nil
+    }
+    o.offset = target
+    return target, nil
+}
+
+func (o *s3Object) Close() error {
+    if o.body != nil {
+        return o.body.Close()
+    }
+    return nil
+}
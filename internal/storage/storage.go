@@ -0,0 +1,52 @@
+package storage
+
+import (
+    "auth2_google/internal/config"
+    "context"
+    "fmt"
+    "io"
+    "os"
+)
+
+// Storage is where uploaded files live. Keys are slash-separated paths like "images/ab/abcd.jpg".
+type Storage interface {
+    Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+    Open(ctx context.Context, key string) (io.ReadSeekCloser, error) // Seekable, for range requests
+    Delete(ctx context.Context, key string) error
+    URL(key string) string // Public URL for the stored object
+}
+
+// NewFromEnv picks the backend from STORAGE_BACKEND ("local" or "s3")
+func NewFromEnv() (Storage, error) {
+    backend := os.Getenv("STORAGE_BACKEND")
+    if backend == "" {
+        backend = "local"
+    }
+
+    switch backend {
+    case "local":
+        dir := os.Getenv("UPLOAD_DIR")
+        if dir == "" {
+            dir = "uploads"
+        }
+        if config.PublicBaseURL() == "" {
+            return nil, fmt.Errorf("PUBLIC_BASE_URL is required for local storage")
+        }
+        return NewLocalStorage(dir, config.PublicBaseURL()+"/uploads"), nil
+    case "s3":
+        cfg := S3Config{
+            Endpoint:        os.Getenv("S3_ENDPOINT"),
+            Region:          os.Getenv("S3_REGION"),
+            Bucket:          os.Getenv("S3_BUCKET"),
+            AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+            SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+            PublicURL:       os.Getenv("S3_PUBLIC_URL"),
+        }
+        if cfg.Endpoint == "" || cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+            return nil, fmt.Errorf("S3_ENDPOINT, S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY are required for s3 storage")
+        }
+        return NewS3Storage(cfg), nil
+    default:
+        return nil, fmt.Errorf("unknown STORAGE_BACKEND %q", backend)
+    }
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+    "html"
+    "regexp"
+    "strings"
+)
+
+var (
+    htmlTagPattern    = regexp.MustCompile(`(?s)<[^>]*>`)
+    whitespacePattern = regexp.MustCompile(`\s+`)
+    paragraphPattern  = regexp.MustCompile(`\n[ \t]*\n\s*`)
+)
+
+// StripHTML turns post content into plain text for indexing, counting and previews
+func StripHTML(content string) string {
+    text := htmlTagPattern.ReplaceAllString(content, " ")
+    text = html.UnescapeString(text)
+    return strings.TrimSpace(whitespacePattern.ReplaceAllString(text, " "))
+}
+
+// Truncate shortens text to at most max characters, cutting at a word boundary
+// when there is one and adding an ellipsis
+func Truncate(text string, max int) string {
+    runes := []rune(text)
+    if len(runes) <= max {
+        return text
+    }
+
+    cut := string(runes[:max-1])
+    if i := strings.LastIndex(cut, " "); i > len(cut)/2 {
+        cut = cut[:i]
+    }
+    return strings.TrimRight(cut, " ,.;:") + "…"
+}
+
+// TextToHTML turns plain text into safe paragraphs. Blank lines separate
+// paragraphs and single line breaks are kept.
+func TextToHTML(text string) string {
+    text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+    if text == "" {
+        return ""
+    }
+
+    var b strings.Builder
+    for _, paragraph := range paragraphPattern.Split(text, -1) {
+        lines := strings.Split(strings.TrimSpace(paragraph), "\n")
+        for i, line := range lines {
+            lines[i] = html.EscapeString(strings.TrimSpace(line))
+        }
+        b.WriteString("<p>" + strings.Join(lines, "<br>") + "</p>\n")
+    }
+    return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+    "errors"
+    "strings"
+    "testing"
+    "time"
+)
+
+func TestParsePreviewToken(t *testing.T) {
+    const secret = "test-secret"
+    future := time.Now().Add(time.Hour).Unix()
+
+    valid, err := GeneratePreviewToken(PreviewClaims{PostID: 7, Version: 2, ExpiresAt: future}, secret)
+    if err != nil {
+        t.Fatalf("GeneratePreviewToken: %v", err)
+    }
+    expired, err := GeneratePreviewToken(PreviewClaims{PostID: 7, Version: 2, ExpiresAt: time.Now().Add(-time.Minute).Unix()}, secret)
+    if err != nil {
+        t.Fatalf("GeneratePreviewToken: %v", err)
+    }
+    other, err := GeneratePreviewToken(PreviewClaims{PostID: 8, Version: 2, ExpiresAt: future}, secret)
+    if err != nil {
+        t.Fatalf("GeneratePreviewToken: %v", err)
+    }
+
+    payload, signature, _ := strings.Cut(valid, ".")
+    otherPayload, _, _ := strings.Cut(other, ".")
+
+    tests := []struct {
+        name    string
+        token   string
+        secret  string
+        wantErr error
+    }{
+        {"valid", valid, secret, nil},
+        {"wrong secret", valid, "other-secret", ErrInvalidPreviewToken},
+        {"empty secret", valid, "", ErrNoPreviewSecret},
+        {"expired", expired, secret, ErrInvalidPreviewToken},
+        {"payload swapped", otherPayload + "." + signature, secret, ErrInvalidPreviewToken},
+        {"signature tampered", payload + "." + strings.Repeat("A", len(signature)), secret, ErrInvalidPreviewToken},
+        {"truncated signature", valid[:len(valid)-4], secret, ErrInvalidPreviewToken},
+        {"missing signature", payload, secret, ErrInvalidPreviewToken},
+        {"empty signature", payload + ".", secret, ErrInvalidPreviewToken},
+        {"empty token", "", secret, ErrInvalidPreviewToken},
+        {"extra part", valid + ".x", secret, ErrInvalidPreviewToken},
+        {"not base64", "@@@." + previewSignature("@@@", secret), secret, ErrInvalidPreviewToken},
+        {"not json", "bm90IGpzb24." + previewSignature("bm90IGpzb24", secret), secret, ErrInvalidPreviewToken},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            claims, err := ParsePreviewToken(tt.token, tt.secret)
+            if !errors.Is(err, tt.wantErr) {
+                t.Fatalf("ParsePreviewToken() error = %v, want %v", err, tt.wantErr)
+            }
+            if tt.wantErr != nil {
+                if claims != nil {
+                    t.Errorf("ParsePreviewToken() returned claims %+v with an error", claims)
+                }
+                return
+            }
+            if claims.PostID != 7 || claims.Version != 2 || claims.ExpiresAt != future {
+                t.Errorf("ParsePreviewToken() = %+v, want post 7 version 2", claims)
+            }
+        })
+    }
+}
+
+func TestGeneratePreviewTokenNeedsSecret(t *testing.T) {
+    if _, err := GeneratePreviewToken(PreviewClaims{PostID: 1}, ""); !errors.Is(err, ErrNoPreviewSecret) {
+        t.Fatalf("GeneratePreviewToken() error = %v, want %v", err, ErrNoPreviewSecret)
+    }
+}
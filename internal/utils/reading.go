@@ -0,0 +1,54 @@
+package utils
+
+import (
+    "math"
+    "strings"
+    "unicode"
+)
+
+// Reading speeds in words per minute. Bangla conjuncts and vowel signs make
+// each word slower to read than a Latin-script one.
+const (
+    latinWordsPerMinute  = 230
+    banglaWordsPerMinute = 150
+
+    // Bangla text pasted from some sources has no spaces between words. A
+    // "word" longer than this is counted as its letters over the average.
+    banglaMaxWordLetters = 12
+    banglaLettersPerWord = 4
+)
+
+// ReadingStats counts the words in post content (HTML) and estimates how many
+// minutes it takes to read, rounded up. Empty content gives 0 and 0.
+func ReadingStats(content string) (words int, minutes int) {
+    latin, bangla := 0, 0
+
+    tokens := strings.FieldsFunc(StripHTML(content), func(r rune) bool {
+        // Zero-width (non-)joiners shape Bangla conjuncts inside a word
+        return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) && r != '‌' && r != '‍'
+    })
+    for _, token := range tokens {
+        letters := 0
+        for _, r := range token {
+            if unicode.Is(unicode.Bengali, r) && unicode.IsLetter(r) {
+                letters++
+            }
+        }
+
+        switch {
+        case letters == 0:
+            latin++
+        case letters > banglaMaxWordLetters:
+            bangla += (letters + banglaLettersPerWord - 1) / banglaLettersPerWord
+        default:
+            bangla++
+        }
+    }
+
+    words = latin + bangla
+    if words == 0 {
+        return 0, 0
+    }
+    exact := float64(latin)/latinWordsPerMinute + float64(bangla)/banglaWordsPerMinute
+    return words, int(math.Max(1, math.Ceil(exact)))
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+    "strings"
+    "unicode"
+)
+
+// Slugify lowercases and joins words with dashes. Non-Latin letters are kept, so Bangla tags get readable slugs.
+func Slugify(text string) string {
+    var b strings.Builder
+    dash := false
+    for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+        if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+            b.WriteRune(r)
+            dash = false
+        } else if !dash && b.Len() > 0 {
+            b.WriteByte('-')
+            dash = true
+        }
+    }
+    return strings.TrimRight(b.String(), "-")
+}
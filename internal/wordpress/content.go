@@ -0,0 +1,82 @@
+package wordpress
+
+import (
+    "net/url"
+    "regexp"
+    "strings"
+)
+
+var (
+    blockCommentPattern = regexp.MustCompile(`<!--\s*/?wp:[^>]*-->`)
+    blockTagPattern     = regexp.MustCompile(`(?i)<(p|div|h[1-6]|ul|ol|li|blockquote|pre|table|figure)[\s>]`)
+    paragraphPattern    = regexp.MustCompile(`\n[ \t]*\n\s*`)
+    imgTagPattern       = regexp.MustCompile(`(?i)<img\s[^>]*>`)
+    srcPattern          = regexp.MustCompile(`(?i)\ssrc=["']([^"']+)["']`)
+    srcsetPattern       = regexp.MustCompile(`(?i)\s(srcset|sizes)=("[^"]*"|'[^']*')`)
+)
+
+// ToHTML turns a stored WordPress body into the HTML our posts hold.
+// Block editor markers are dropped, and classic editor content, which
+// WordPress only wraps in paragraphs when rendering, gets its paragraphs.
+func ToHTML(content string) string {
+    content = strings.ReplaceAll(content, "\r\n", "\n")
+    content = strings.TrimSpace(blockCommentPattern.ReplaceAllString(content, ""))
+    if content == "" || blockTagPattern.MatchString(content) {
+        return content
+    }
+
+    paragraphs := []string{}
+    for _, paragraph := range paragraphPattern.Split(content, -1) {
+        paragraph = strings.TrimSpace(paragraph)
+        if paragraph != "" {
+            paragraphs = append(paragraphs, "<p>"+strings.ReplaceAll(paragraph, "\n", "<br>")+"</p>")
+        }
+    }
+    return strings.Join(paragraphs, "\n")
+}
+
+// ImageURLs lists the images in the content, in order and without repeats
+func ImageURLs(content string) []string {
+    urls := []string{}
+    seen := map[string]bool{}
+    for _, tag := range imgTagPattern.FindAllString(content, -1) {
+        match := srcPattern.FindStringSubmatch(tag)
+        if match != nil && !seen[match[1]] {
+            urls = append(urls, match[1])
+            seen[match[1]] = true
+        }
+    }
+    return urls
+}
+
+// ReplaceImages points images at their new URLs. Resized copies listed in
+// srcset would still load from the old site, so those attributes go.
+func ReplaceImages(content string, urls map[string]string) string {
+    return imgTagPattern.ReplaceAllStringFunc(content, func(tag string) string {
+        match := srcPattern.FindStringSubmatch(tag)
+        if match == nil {
+            return tag
+        }
+        src := match[1]
+        replacement, ok := urls[src]
+        if !ok {
+            return tag
+        }
+        tag = srcsetPattern.ReplaceAllString(tag, "")
+        return strings.Replace(tag, src, replacement, 1)
+    })
+}
+
+// SameSite reports whether link is hosted on the exported site. Only those
+// images are downloaded, embeds from elsewhere are left alone.
+func SameSite(link, siteURL string) bool {
+    a, err := url.Parse(link)
+    if err != nil {
+        return false
+    }
+    b, err := url.Parse(siteURL)
+    if err != nil {
+        return false
+    }
+    return a.Host != "" && strings.EqualFold(strings.TrimPrefix(a.Host, "www."), strings.TrimPrefix(b.Host, "www."))
+}
@@ -0,0 +1,152 @@
+package wordpress
+
+import (
+    "encoding/xml"
+    "fmt"
+    "io"
+    "strings"
+    "time"
+)
+
+// Export is a parsed WordPress eXtended RSS (WXR) file. Elements are matched
+// by local name so exports from any WXR version (1.0-1.2) parse the same.
+type Export struct {
+    Channel Channel `xml:"channel"`
+}
+
+type Channel struct {
+    Title       string   `xml:"title"`
+    Language    string   `xml:"language"`
+    BaseSiteURL string   `xml:"base_site_url"`
+    BaseBlogURL string   `xml:"base_blog_url"`
+    Authors     []Author `xml:"author"`
+    Items       []Item   `xml:"item"`
+}
+
+type Author struct {
+    Login       string `xml:"author_login"`
+    Email       string `xml:"author_email"`
+    DisplayName string `xml:"author_display_name"`
+}
+
+type Item struct {
+    Title         string     `xml:"title"`
+    Link          string     `xml:"link"`
+    Creator       string     `xml:"creator"` // Author login
+    Encoded       []Encoded  `xml:"encoded"` // content:encoded and excerpt:encoded
+    PostID        string     `xml:"post_id"`
+    PostDateGMT   string     `xml:"post_date_gmt"`
+    PostDate      string     `xml:"post_date"`
+    ModifiedGMT   string     `xml:"post_modified_gmt"`
+    Status        string     `xml:"status"`
+    PostType      string     `xml:"post_type"`
+    AttachmentURL string     `xml:"attachment_url"`
+    Categories    []Category `xml:"category"`
+    Meta          []Meta     `xml:"postmeta"`
+    Comments      []Comment  `xml:"comment"`
+}
+
+// Encoded holds either the body or the excerpt, told apart by namespace
+type Encoded struct {
+    XMLName xml.Name
+    Value   string `xml:",chardata"`
+}
+
+type Category struct {
+    Domain   string `xml:"domain,attr"` // "category" or "post_tag"
+    Nicename string `xml:"nicename,attr"`
+    Name     string `xml:",chardata"`
+}
+
+type Meta struct {
+    Key   string `xml:"meta_key"`
+    Value string `xml:"meta_value"`
+}
+
+type Comment struct {
+    ID          string `xml:"comment_id"`
+    Author      string `xml:"comment_author"`
+    AuthorEmail string `xml:"comment_author_email"`
+    DateGMT     string `xml:"comment_date_gmt"`
+    Date        string `xml:"comment_date"`
+    Content     string `xml:"comment_content"`
+    Approved    string `xml:"comment_approved"` // "1", "0", "spam" or "trash"
+    Type        string `xml:"comment_type"`     // "", "comment", "pingback" or "trackback"
+    ParentID    string `xml:"comment_parent"`   // "0" for top-level comments
+}
+
+// Parse reads a WXR export
+func Parse(r io.Reader) (*Export, error) {
+    var export Export
+    decoder := xml.NewDecoder(r)
+    decoder.Strict = false // Real-world exports aren't always well-formed
+    if err := decoder.Decode(&export); err != nil {
+        return nil, fmt.Errorf("not a valid WordPress export: %v", err)
+    }
+    if export.Channel.BaseSiteURL == "" && export.Channel.BaseBlogURL == "" {
+        return nil, fmt.Errorf("not a WordPress export: base_site_url is missing")
+    }
+    return &export, nil
+}
+
+// SiteURL identifies the WordPress site the export came from
+func (c *Channel) SiteURL() string {
+    if c.BaseBlogURL != "" {
+        return strings.TrimRight(c.BaseBlogURL, "/")
+    }
+    return strings.TrimRight(c.BaseSiteURL, "/")
+}
+
+// Content is the post body as stored by WordPress
+func (i *Item) Content() string {
+    for _, encoded := range i.Encoded {
+        if !strings.Contains(encoded.XMLName.Space, "excerpt") {
+            return encoded.Value
+        }
+    }
+    return ""
+}
+
+func (i *Item) Excerpt() string {
+    for _, encoded := range i.Encoded {
+        if strings.Contains(encoded.XMLName.Space, "excerpt") {
+            return encoded.Value
+        }
+    }
+    return ""
+}
+
+func (i *Item) MetaValue(key string) string {
+    for _, meta := range i.Meta {
+        if meta.Key == key {
+            return meta.Value
+        }
+    }
+    return ""
+}
+
+// Created prefers the GMT date; drafts only have a local one
+func (i *Item) Created() time.Time {
+    return parseDate(i.PostDateGMT, i.PostDate)
+}
+
+func (i *Item) Modified() time.Time {
+    if modified := parseDate(i.ModifiedGMT, ""); !modified.IsZero() {
+        return modified
+    }
+    return i.Created()
+}
+
+func (c *Comment) Created() time.Time {
+    return parseDate(c.DateGMT, c.Date)
+}
+
+// WordPress writes "0000-00-00 00:00:00" for dates it doesn't have
+func parseDate(gmt, local string) time.Time {
+    for _, value := range []string{gmt, local} {
+        if t, err := time.Parse("2006-01-02 15:04:05", strings.TrimSpace(value)); err == nil && t.Year() > 1 {
+            return t
+        }
+    }
+    return time.Time{}
+}
@@ -1,12 +1,16 @@
 package main
 
 import (
+    "auth2_google/internal/captcha"
     "auth2_google/internal/config"
     "auth2_google/internal/controllers"
+    "auth2_google/internal/imaging"
     "auth2_google/internal/middleware"
     "auth2_google/internal/models"
     "auth2_google/internal/repositories"
+    "auth2_google/internal/search"
     "auth2_google/internal/services"
+    "auth2_google/internal/storage"
     "auth2_google/pkg/database"
     "log"
     "net/http"
@@ -39,26 +43,147 @@ func main() {
     // Connect to database
     database.ConnectDatabase()
 
+    // Posts from before drafts existed were all live, remember that before the column appears
+    backfillPublished := database.NeedsPublishedBackfill()
+
     // Auto-migrate database tables
-    database.DB.AutoMigrate(&models.User{}, &models.BlogPost{}, &models.Comment{})
+    database.DB.AutoMigrate(&models.User{}, &models.BlogPost{}, &models.PostAuthor{}, &models.PostTranslation{}, &models.Tag{}, &models.Comment{}, &models.ArchivedComment{}, &models.Media{}, &models.MediaVariant{}, &models.PostAudio{}, &models.PodcastSettings{}, &models.PostViewDaily{}, &models.SiteVisitorDaily{}, &models.ReferrerDaily{}, &models.PostReaction{}, &models.Bookmark{}, &models.ReadingList{}, &models.ReadingListItem{}, &models.Series{}, &models.SeriesPost{}, &models.FeaturedPost{}, &models.HomepageSlot{}, &models.HomepageSlotPost{}, &models.PostReviewer{}, &models.ReviewNote{}, &models.Submission{}, &models.SubmissionAttachment{}, &models.ImportRecord{})
     log.Println("✅ Database tables created/updated")
+    if backfillPublished {
+        if err := database.BackfillPublished(); err != nil {
+            log.Fatal("❌ Failed to mark existing posts as published:", err)
+        }
+        log.Println("✅ Existing posts marked as published")
+    }
+    if linked, err := database.BackfillPostAuthors(); err != nil {
+        log.Fatal("❌ Failed to link legacy posts to their authors:", err)
+    } else if linked > 0 {
+        log.Printf("✅ Linked %d legacy posts to their authors", linked)
+    }
 
     // Initialize Google OAuth2 configuration
     config.InitGoogleOAuth()
     log.Println("✅ Google OAuth2 configured")
 
+    // Search backend (SEARCH_BACKEND=postgres|embedded)
+    searchIndex, err := search.NewFromEnv(database.DB)
+    if err != nil {
+        log.Fatal("❌ Failed to set up search index:", err)
+    }
+    log.Println("✅ Search index ready")
+
+    // Upload storage (STORAGE_BACKEND=local|s3)
+    store, err := storage.NewFromEnv()
+    if err != nil {
+        log.Fatal("❌ Failed to set up storage:", err)
+    }
+    log.Println("✅ Storage ready")
+
+    // Spam protection for guest submissions (CAPTCHA_PROVIDER=hcaptcha|turnstile|recaptcha|none)
+    captchaVerifier, err := captcha.NewFromEnv()
+    if err != nil {
+        log.Fatal("❌ Failed to set up captcha:", err)
+    }
+    if captcha.Disabled(captchaVerifier) {
+        log.Println("⚠️ CAPTCHA_PROVIDER=none, guest submissions are not protected by a captcha")
+    } else {
+        log.Println("✅ Captcha ready")
+    }
+
     // Dependency injection
+    userRepo := repositories.NewUserRepository(database.DB)
+
+    mediaRepo := repositories.NewMediaRepository(database.DB)
+
+    tagRepo := repositories.NewTagRepository(database.DB)
+    reactionRepo := repositories.NewReactionRepository(database.DB)
+    bookmarkRepo := repositories.NewBookmarkRepository(database.DB)
+    seriesRepo := repositories.NewSeriesRepository(database.DB)
+
     blogRepo := repositories.NewBlogRepository(database.DB)
-    blogService := services.NewBlogService(blogRepo)
+    relatedIndex := search.NewRelatedIndex(blogRepo.GetPublishedForRelated)
+    blogService := services.NewBlogService(blogRepo, userRepo, mediaRepo, tagRepo, reactionRepo, bookmarkRepo, seriesRepo, searchIndex, relatedIndex)
     blogController := controllers.NewBlogController(blogService)
 
+    relatedService := services.NewRelatedService(blogRepo, relatedIndex, blogService)
+    relatedController := controllers.NewRelatedController(relatedService)
+
+    seriesService := services.NewSeriesService(seriesRepo, blogRepo, blogService)
+    seriesController := controllers.NewSeriesController(seriesService)
+
+    homepageRepo := repositories.NewHomepageRepository(database.DB)
+    homepageService := services.NewHomepageService(homepageRepo, blogRepo, blogService)
+    homepageController := controllers.NewHomepageController(homepageService)
+
     commentRepo := repositories.NewCommentRepository(database.DB)
-    commentService := services.NewCommentService(commentRepo)
+    commentService := services.NewCommentService(commentRepo, blogRepo)
     commentController := controllers.NewCommentController(commentService)
 
+    imageProcessor := imaging.NewProcessor(config.WebPEncoderPath())
+    uploadService := services.NewUploadService(mediaRepo, store, imageProcessor, config.UploadMaxBytes())
+    uploadController := controllers.NewUploadController(uploadService)
+
+    audioRepo := repositories.NewAudioRepository(database.DB)
+    audioService := services.NewAudioService(blogRepo, audioRepo, userRepo, store, config.AudioMaxBytes())
+    audioController := controllers.NewAudioController(audioService)
+
+    podcastRepo := repositories.NewPodcastRepository(database.DB)
+    podcastService := services.NewPodcastService(blogRepo, podcastRepo)
+    podcastController := controllers.NewPodcastController(podcastService, audioService)
+
+    feedService := services.NewFeedService(blogRepo, tagRepo, userRepo)
+    feedController := controllers.NewFeedController(feedService)
+
+    translationRepo := repositories.NewTranslationRepository(database.DB)
+    translationService := services.NewTranslationService(blogRepo, translationRepo, userRepo)
+    translationController := controllers.NewTranslationController(translationService)
+
+    analyticsRepo := repositories.NewAnalyticsRepository(database.DB)
+    analyticsService := services.NewAnalyticsService(blogRepo, analyticsRepo)
+    analyticsController := controllers.NewAnalyticsController(analyticsService)
+    go analyticsService.Run(config.AnalyticsFlushInterval())
+
+    readingListRepo := repositories.NewReadingListRepository(database.DB)
+    bookmarkService := services.NewBookmarkService(blogRepo, bookmarkRepo, readingListRepo, blogService)
+    bookmarkController := controllers.NewBookmarkController(bookmarkService)
+
+    reactionService := services.NewReactionService(blogRepo, reactionRepo)
+    reactionController := controllers.NewReactionController(reactionService)
+
+    metaService := services.NewMetaService(blogRepo)
+    metaController := controllers.NewMetaController(metaService)
+
+    sitemapService := services.NewSitemapService(blogRepo, translationRepo)
+    sitemapController := controllers.NewSitemapController(sitemapService)
+
+    previewService := services.NewPreviewService(blogRepo, userRepo, blogService)
+    previewController := controllers.NewPreviewController(previewService)
+
+    reviewRepo := repositories.NewReviewRepository(database.DB)
+    reviewService := services.NewReviewService(reviewRepo, blogRepo, userRepo)
+    reviewController := controllers.NewReviewController(reviewService)
+
+    submissionRepo := repositories.NewSubmissionRepository(database.DB)
+    submissionService := services.NewSubmissionService(submissionRepo, blogRepo, userRepo, store, captchaVerifier, searchIndex, config.SubmissionMaxBytes())
+    submissionController := controllers.NewSubmissionController(submissionService)
+
+    importRepo := repositories.NewImportRepository(database.DB)
+    importService := services.NewImportService(importRepo, blogRepo, tagRepo, userRepo, mediaRepo, uploadService, searchIndex, relatedIndex)
+    importController := controllers.NewImportController(importService)
+
+    trashRepo := repositories.NewTrashRepository(database.DB)
+    trashService := services.NewTrashService(trashRepo, blogRepo, audioService, searchIndex, relatedIndex)
+    trashController := controllers.NewTrashController(trashService)
+    go trashService.Run(time.Hour)
+
     // Setup Gin router
     router := gin.New() // Use gin.New() for more control over middleware
 
+    // Client IPs feed rate limits and visitor counts, only take X-Forwarded-For from our own proxies
+    if err := router.SetTrustedProxies(config.TrustedProxies()); err != nil {
+        log.Fatal("❌ Invalid TRUSTED_PROXIES:", err)
+    }
+
     // Add middleware
     router.Use(middleware.BasicLogger())
     router.Use(gin.Recovery()) // Handle panics gracefully
@@ -72,7 +197,7 @@ func main() {
         "http://localhost:3001",          // 🔥 Alternative local port
     },
         AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-        AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+        AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Reader-Fingerprint"},
         ExposeHeaders:    []string{"Content-Length"},
         AllowCredentials: true,
         MaxAge:          12 * time.Hour,
@@ -98,24 +223,150 @@ func main() {
         })
     })
 
+    // Serve uploaded files when they are stored on local disk
+    if local, ok := store.(*storage.LocalStorage); ok {
+        router.Static("/uploads", local.Dir())
+    }
+
+    // Feeds
+    router.GET("/feeds/podcast.xml", podcastController.GetFeed)
+    for _, prefix := range []string{"/feeds", "/feeds/tags/:slug", "/feeds/authors/:id"} {
+        router.GET(prefix+"/rss.xml", feedController.Feed(models.FeedRSS))
+        router.GET(prefix+"/atom.xml", feedController.Feed(models.FeedAtom))
+        router.GET(prefix+"/feed.json", feedController.Feed(models.FeedJSON))
+    }
+
+    // Crawlers
+    router.GET("/sitemap.xml", sitemapController.GetSitemap)
+    router.GET("/sitemaps/:file", sitemapController.GetSitemapPage)
+    router.GET("/robots.txt", sitemapController.GetRobots)
+    router.GET("/share/posts/:id", metaController.SharePage)
+
     // Auth routes
     router.GET("/auth/google/login", controllers.GoogleLogin)
     router.GET("/auth/google/callback", controllers.GoogleCallback)
 
     // Public blog routes
-    router.GET("/api/posts", blogController.GetAllPosts)
-    router.GET("/api/posts/published", blogController.GetPublishedPosts)
-    router.GET("/api/posts/:id", blogController.GetPost)
+    // Signed-in readers also see which posts they saved
+    router.GET("/api/posts", middleware.OptionalAuth(), blogController.GetAllPosts)
+    router.GET("/api/posts/published", middleware.OptionalAuth(), blogController.GetPublishedPosts)
+    router.GET("/api/posts/search", middleware.OptionalAuth(), blogController.SearchPosts)
+    router.GET("/api/posts/featured", middleware.OptionalAuth(), homepageController.GetFeaturedPosts)
+    router.GET("/api/homepage", middleware.OptionalAuth(), homepageController.GetHomepage)
+    router.GET("/api/posts/:id", middleware.OptionalAuth(), blogController.GetPost)
+    router.GET("/api/posts/:id/languages", middleware.OptionalAuth(), translationController.GetLanguages)
+    router.GET("/api/posts/:id/meta", metaController.GetPostMeta)
+    router.GET("/api/posts/:id/related", middleware.OptionalAuth(), relatedController.GetRelated)
+    router.GET("/api/preview/:token", previewController.GetPreview)
+
+    // Guest submissions
+    router.POST("/api/submissions", submissionController.Submit)
+    router.GET("/api/submissions/status/:token", submissionController.GetStatus)
+    router.POST("/api/posts/:id/views", analyticsController.RecordView)
+    router.GET("/api/posts/:id/audio", audioController.StreamAudio)
+    router.HEAD("/api/posts/:id/audio", audioController.StreamAudio)
+
+    // Series routes
+    router.GET("/api/series", seriesController.GetAllSeries)
+    router.GET("/api/series/:slug", middleware.OptionalAuth(), seriesController.GetSeries)
+
+    // Reactions work signed in or anonymously
+    reactions := router.Group("/api/posts/:id/reactions")
+    reactions.Use(middleware.OptionalAuth())
+    reactions.GET("", reactionController.GetReactions)
+    reactions.PUT("/:reaction", reactionController.AddReaction)
+    reactions.DELETE("/:reaction", reactionController.RemoveReaction)
 
     // Protected blog routes
     protected := router.Group("/api")
-    // TODO: Add authentication middleware when ready
-    // protected.Use(middleware.RequireAuth())
-    
+    protected.Use(middleware.RequireAuth()) // Posts are attributed to the authenticated caller
+
     protected.POST("/posts", blogController.CreatePost)
     protected.PUT("/posts/:id", blogController.UpdatePost)
     protected.DELETE("/posts/:id", blogController.DeletePost)
 
+    // Draft preview links
+    protected.POST("/posts/:id/preview-links", previewController.CreatePreviewLink)
+    protected.DELETE("/posts/:id/preview-links", previewController.RevokePreviewLinks)
+
+    // Editorial review, open to the post's authors and editors
+    protected.GET("/posts/:id/review", reviewController.GetReview)
+    protected.PUT("/posts/:id/review/status", reviewController.Transition)
+    protected.POST("/posts/:id/review/notes", reviewController.AddNote)
+    protected.PUT("/review-notes/:noteId", reviewController.ResolveNote)
+    protected.DELETE("/review-notes/:noteId", reviewController.DeleteNote)
+
+    // Translation routes
+    protected.GET("/posts/:id/translations", translationController.GetTranslations)
+    protected.PUT("/posts/:id/translations/:lang", translationController.SaveTranslation)
+    protected.DELETE("/posts/:id/translations/:lang", translationController.DeleteTranslation)
+
+    // Audio narration routes
+    protected.POST("/posts/:id/audio", audioController.UploadAudio)
+    protected.DELETE("/posts/:id/audio", audioController.DeleteAudio)
+    protected.PUT("/posts/:id/audio/episode", podcastController.UpdateEpisode)
+
+    // Upload routes
+    protected.POST("/uploads", uploadController.UploadImage)
+    protected.GET("/uploads", uploadController.GetMyMedia)
+
+    // Editor routes
+    editor := router.Group("/api")
+    editor.Use(middleware.RequireAuth(), middleware.RequireRole(userRepo, models.RoleEditor, models.RoleAdmin))
+
+    editor.POST("/posts/bulk", blogController.BulkUpdatePosts)
+    editor.POST("/series", seriesController.CreateSeries)
+    editor.PUT("/series/:id", seriesController.UpdateSeries)
+    editor.DELETE("/series/:id", seriesController.DeleteSeries)
+    editor.PUT("/series/:id/posts", seriesController.SetSeriesPosts)
+    editor.PUT("/posts/:id/featured", homepageController.FeaturePost)
+    editor.DELETE("/posts/:id/featured", homepageController.UnfeaturePost)
+    editor.GET("/homepage/slots", homepageController.GetSlots)
+    editor.PUT("/homepage/slots/:name", homepageController.SaveSlot)
+    editor.DELETE("/homepage/slots/:name", homepageController.DeleteSlot)
+    editor.GET("/review-queue", reviewController.GetQueue)
+    editor.PUT("/posts/:id/reviewers", reviewController.AssignReviewers)
+    editor.GET("/submissions", submissionController.GetSubmissions)
+    editor.GET("/submissions/:id", submissionController.GetSubmission)
+    editor.GET("/submissions/:id/attachments/:attachmentId", submissionController.DownloadAttachment)
+    editor.PUT("/submissions/:id/status", submissionController.SetStatus)
+    editor.POST("/submissions/:id/accept", submissionController.Accept)
+
+    // Reader routes
+    me := router.Group("/api/me")
+    me.Use(middleware.RequireAuth())
+
+    me.GET("/bookmarks", bookmarkController.GetBookmarks)
+    me.PUT("/bookmarks/:postId", bookmarkController.SaveBookmark)
+    me.DELETE("/bookmarks/:postId", bookmarkController.RemoveBookmark)
+
+    me.GET("/lists", bookmarkController.GetReadingLists)
+    me.POST("/lists", bookmarkController.CreateReadingList)
+    me.GET("/lists/:listId", bookmarkController.GetReadingList)
+    me.PUT("/lists/:listId", bookmarkController.RenameReadingList)
+    me.DELETE("/lists/:listId", bookmarkController.DeleteReadingList)
+    me.PUT("/lists/:listId/order", bookmarkController.ReorderReadingList)
+    me.PUT("/lists/:listId/posts/:postId", bookmarkController.AddToReadingList)
+    me.DELETE("/lists/:listId/posts/:postId", bookmarkController.RemoveFromReadingList)
+
+    // Admin routes
+    admin := router.Group("/api/admin")
+    admin.Use(middleware.RequireAuth(), middleware.RequireRole(userRepo, models.RoleAdmin))
+
+    admin.GET("/podcast", podcastController.GetSettings)
+    admin.PUT("/podcast", podcastController.UpdateSettings)
+    admin.GET("/analytics", analyticsController.GetReport)
+
+    // Trash routes
+    admin.GET("/trash", trashController.GetTrash)
+    admin.POST("/trash/posts/:id/restore", trashController.RestorePost)
+    admin.POST("/trash/comments/:id/restore", trashController.RestoreComment)
+    admin.DELETE("/trash/posts/:id", trashController.PurgePost)
+    admin.DELETE("/trash/comments/:id", trashController.PurgeComment)
+
+    // Imports
+    admin.POST("/import/wordpress", importController.ImportWordPress)
+
     // Comment routes
     router.POST("/api/blogs/:id/comments", commentController.CreateComment)
     router.GET("/api/blogs/:id/comments", commentController.GetCommentsByBlog)
@@ -145,6 +396,7 @@ func validateEnvironmentVariables() {
         "GOOGLE_CLIENT_SECRET",  // For Google OAuth  
         "JWT_SECRET",
         "FRONTEND_URL",           // For generating JWT tokens
+        "PUBLIC_BASE_URL",        // This API's own address, for feed, podcast and upload links
     }
     
     // Check each variable one by one
@@ -158,5 +410,10 @@ func validateEnvironmentVariables() {
         }
     }
     
+    // Preview links fall back to JWT_SECRET, but never sign with an empty key
+    if config.PreviewSecret() == "" {
+        log.Fatal("❌ PREVIEW_SECRET or JWT_SECRET must be set to sign preview links")
+    }
+
     log.Println("✅ All required environment variables validated")
 }
\ No newline at end of file
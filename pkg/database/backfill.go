@@ -0,0 +1,43 @@
+package database
+
+import "gorm.io/gorm"
+
+// NeedsPublishedBackfill reports whether blog_posts predates the published
+// column. Check it before AutoMigrate, which adds the column as false.
+func NeedsPublishedBackfill() bool {
+    return DB.Migrator().HasTable("blog_posts") && !DB.Migrator().HasColumn("blog_posts", "published")
+}
+
+// BackfillPublished keeps every post from before the published column live,
+// trashed ones included so restoring them brings them back as they were
+func BackfillPublished() error {
+    return DB.Exec("UPDATE blog_posts SET published = true, published_at = created_at").Error
+}
+
+// BackfillPostAuthors links legacy posts, which only carry the author's display
+// name, to the account with that name so the author can edit them again.
+// Names shared by several accounts are ambiguous and left for an editor.
+// Safe to run on every start, it only touches posts without an author.
+func BackfillPostAuthors() (int64, error) {
+    var linked int64
+    err := DB.Transaction(func(tx *gorm.DB) error {
+        result := tx.Exec(`UPDATE blog_posts SET author_id = users.id
+            FROM users
+            WHERE blog_posts.author_id IS NULL
+              AND users.deleted_at IS NULL
+              AND users.name = blog_posts.author
+              AND (SELECT COUNT(*) FROM users AS same WHERE same.name = users.name AND same.deleted_at IS NULL) = 1`)
+        if result.Error != nil {
+            return result.Error
+        }
+        linked = result.RowsAffected
+
+        // The primary author also heads the author list
+        return tx.Exec(`INSERT INTO post_authors (blog_post_id, user_id, position)
+            SELECT id, author_id, 0 FROM blog_posts
+            WHERE author_id IS NOT NULL
+              AND NOT EXISTS (SELECT 1 FROM post_authors WHERE post_authors.blog_post_id = blog_posts.id)
+            ON CONFLICT DO NOTHING`).Error
+    })
+    return linked, err
+}